@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
@@ -27,24 +28,29 @@ import (
 )
 
 type AWSClient struct {
-	accountID                 string
-	awsConfig                 *aws.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	defaultTagsConfig         *tftags.DefaultConfig
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	ignoreTagsConfig          *tftags.IgnoreConfig
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	region                    string
-	servicePackages           map[string]ServicePackage
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	accountID                          string
+	awsConfig                          *aws.Config
+	clients                            map[string]any
+	conns                              map[string]any
+	customPartition                    *CustomPartition // From provider configuration.
+	decodeAuthorizationMessages        bool             // From provider configuration.
+	defaultTagsConfig                  *tftags.DefaultConfig
+	defaultTimeouts                    []DefaultTimeouts // From provider configuration.
+	endpoints                          map[string]string // From provider configuration.
+	httpClient                         *http.Client
+	ignoreTagsConfig                   *tftags.IgnoreConfig
+	lock                               sync.Mutex
+	logger                             baselogging.Logger
+	partition                          endpoints.Partition
+	region                             string
+	serviceAvailabilityPreflightConfig *ServiceAvailabilityPreflightConfig
+	servicePackages                    map[string]ServicePackage
+	serviceQuotaPreflightConfig        *ServiceQuotaPreflightConfig
+	session                            *session_sdkv1.Session
+	s3ExpressClient                    *s3.Client
+	s3UsePathStyle                     bool   // From provider configuration.
+	s3USEast1RegionalEndpoint          string // From provider configuration.
+	stsRegion                          string // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -71,7 +77,13 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the default tags configuration,
+// resolved for the resource type if the Context carries a resource.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if v, ok := FromContext(ctx); ok && v.TypeName != "" {
+		return c.defaultTagsConfig.ForResourceType(v.TypeName)
+	}
+
 	return c.defaultTagsConfig
 }
 
@@ -79,6 +91,43 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+// AuthorizationMessageDecoder returns a decoder for encoded authorization failure messages that calls STS DecodeAuthorizationMessage,
+// or nil if decoding is disabled.
+func (c *AWSClient) AuthorizationMessageDecoder(context.Context) errs.AuthorizationMessageDecoder {
+	if !c.decodeAuthorizationMessages {
+		return nil
+	}
+
+	return func(ctx context.Context, encodedMessage string) (string, error) {
+		input := sts.DecodeAuthorizationMessageInput{
+			EncodedMessage: aws.String(encodedMessage),
+		}
+
+		output, err := c.STSClient(ctx).DecodeAuthorizationMessage(ctx, &input)
+
+		if err != nil {
+			return "", err
+		}
+
+		return aws.ToString(output.DecodedMessage), nil
+	}
+}
+
+// DefaultTimeouts returns the provider-level default timeouts for the specified resource type, if any.
+func (c *AWSClient) DefaultTimeouts(_ context.Context, resourceType string) (*DefaultTimeouts, bool) {
+	return resolveDefaultTimeouts(c.defaultTimeouts, resourceType)
+}
+
+// ServiceAvailabilityPreflightConfig returns the plan-time service availability check configuration, or nil if the checks are disabled.
+func (c *AWSClient) ServiceAvailabilityPreflightConfig(context.Context) *ServiceAvailabilityPreflightConfig {
+	return c.serviceAvailabilityPreflightConfig
+}
+
+// ServiceQuotaPreflightConfig returns the plan-time Service Quotas check configuration, or nil if the checks are disabled.
+func (c *AWSClient) ServiceQuotaPreflightConfig(context.Context) *ServiceQuotaPreflightConfig {
+	return c.serviceQuotaPreflightConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -99,6 +148,10 @@ func (c *AWSClient) AccountID(context.Context) string {
 
 // Partition returns the ID of the configured AWS partition.
 func (c *AWSClient) Partition(context.Context) string {
+	if c.customPartition != nil {
+		return c.customPartition.ID
+	}
+
 	return c.partition.ID()
 }
 
@@ -124,6 +177,15 @@ func (c *AWSClient) GlobalARN(ctx context.Context, service, resource string) str
 	}.String()
 }
 
+// GlobalARNNoAccount returns a global (no Region) ARN for the specified service namespace and resource without AWS account ID.
+func (c *AWSClient) GlobalARNNoAccount(ctx context.Context, service, resource string) string {
+	return arn.ARN{
+		Partition: c.Partition(ctx),
+		Service:   service,
+		Resource:  resource,
+	}.String()
+}
+
 // RegionalARN returns a regional ARN for the specified service namespace and resource.
 func (c *AWSClient) RegionalARN(ctx context.Context, service, resource string) string {
 	return c.RegionalARNWithAccount(ctx, service, c.AccountID(ctx), resource)
@@ -222,6 +284,9 @@ func (c *AWSClient) APIGatewayV2InvokeURL(ctx context.Context, protocolType apig
 // CloudFrontDistributionHostedZoneID returns the Route 53 hosted zone ID
 // for Amazon CloudFront distributions in the configured AWS partition.
 func (c *AWSClient) CloudFrontDistributionHostedZoneID(ctx context.Context) string {
+	if v, ok := c.CustomPartitionHostedZoneID(ctx, c.Region(ctx), HostedZoneIDKeyCloudFront); ok {
+		return v
+	}
 	if c.Partition(ctx) == endpoints.AwsCnPartitionID {
 		return "Z3RFFRIM2A3IF5" // See https://docs.amazonaws.cn/en_us/aws/latest/userguide/route53.html
 	}
@@ -251,12 +316,19 @@ func (c *AWSClient) DefaultKMSKeyPolicy(ctx context.Context) string {
 
 // GlobalAcceleratorHostedZoneID returns the Route 53 hosted zone ID
 // for AWS Global Accelerator accelerators in the configured AWS partition.
-func (c *AWSClient) GlobalAcceleratorHostedZoneID(context.Context) string {
+func (c *AWSClient) GlobalAcceleratorHostedZoneID(ctx context.Context) string {
+	if v, ok := c.CustomPartitionHostedZoneID(ctx, c.Region(ctx), HostedZoneIDKeyGlobalAccelerator); ok {
+		return v
+	}
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
 // DNSSuffix returns the domain suffix for the configured AWS partition.
 func (c *AWSClient) DNSSuffix(context.Context) string {
+	if c.customPartition != nil {
+		return c.customPartition.DNSSuffix
+	}
+
 	dnsSuffix := c.partition.DNSSuffix()
 	if dnsSuffix == "" {
 		dnsSuffix = "amazonaws.com"
@@ -265,6 +337,18 @@ func (c *AWSClient) DNSSuffix(context.Context) string {
 	return dnsSuffix
 }
 
+// CustomPartitionHostedZoneID returns the Route 53 hosted zone ID with the specified key (HostedZoneIDKey*)
+// for the specified AWS Region, if the Region is in the configured custom partition.
+func (c *AWSClient) CustomPartitionHostedZoneID(_ context.Context, region, key string) (string, bool) {
+	if c.customPartition == nil || !c.customPartition.containsRegion(region) {
+		return "", false
+	}
+
+	v, ok := c.customPartition.HostedZoneIDs[key]
+
+	return v, ok && v != ""
+}
+
 // ReverseDNSPrefix returns the reverse DNS prefix for the configured AWS partition.
 func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 	return ReverseDNS(c.DNSSuffix(ctx))
@@ -7,12 +7,18 @@ import (
 	"context"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 )
 
 var (
 	standardPartition, _ = endpoints.PartitionForRegion(endpoints.DefaultPartitions(), endpoints.UsEast1RegionID)
 	chinaPartition, _    = endpoints.PartitionForRegion(endpoints.DefaultPartitions(), endpoints.CnNorth1RegionID)
+	customPartition      = &CustomPartition{
+		ID:          "aws-example",
+		RegionRegex: regexache.MustCompile(`^example-\w+-\d+$`),
+		DNSSuffix:   "cloud.example.com",
+	}
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -41,6 +47,15 @@ func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-
 			Prefix:   "test",
 			Expected: "test.amazonaws.com.cn",
 		},
+		{
+			Name: "Custom",
+			AWSClient: &AWSClient{
+				customPartition: customPartition,
+				partition:       standardPartition,
+			},
+			Prefix:   "test",
+			Expected: "test.cloud.example.com",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -84,6 +99,16 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Prefix:   "test",
 			Expected: "test.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
 		},
+		{
+			Name: "Custom",
+			AWSClient: &AWSClient{
+				customPartition: customPartition,
+				partition:       standardPartition,
+				region:          "example-east-1",
+			},
+			Prefix:   "test",
+			Expected: "test.example-east-1.cloud.example.com",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -27,41 +27,47 @@ import (
 )
 
 type Config struct {
-	AccessKey                      string
-	AllowedAccountIds              []string
-	AssumeRole                     []awsbase.AssumeRole
-	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
-	CustomCABundle                 string
-	DefaultTagsConfig              *tftags.DefaultConfig
-	EC2MetadataServiceEnableState  imds.ClientEnableState
-	EC2MetadataServiceEndpoint     string
-	EC2MetadataServiceEndpointMode string
-	Endpoints                      map[string]string
-	ForbiddenAccountIds            []string
-	HTTPProxy                      *string
-	HTTPSProxy                     *string
-	IgnoreTagsConfig               *tftags.IgnoreConfig
-	Insecure                       bool
-	MaxRetries                     int
-	NoProxy                        string
-	Profile                        string
-	Region                         string
-	RetryMode                      aws.RetryMode
-	S3UsePathStyle                 bool
-	S3USEast1RegionalEndpoint      string
-	SecretKey                      string
-	SharedConfigFiles              []string
-	SharedCredentialsFiles         []string
-	SkipCredsValidation            bool
-	SkipRegionValidation           bool
-	SkipRequestingAccountId        bool
-	STSRegion                      string
-	SuppressDebugLog               bool
-	TerraformVersion               string
-	Token                          string
-	TokenBucketRateLimiterCapacity int
-	UseDualStackEndpoint           bool
-	UseFIPSEndpoint                bool
+	AccessKey                          string
+	AllowedAccountIds                  []string
+	AssumeRole                         []awsbase.AssumeRole
+	AssumeRoleWithWebIdentity          *awsbase.AssumeRoleWithWebIdentity
+	CustomCABundle                     string
+	CustomPartition                    *CustomPartition
+	DecodeAuthorizationMessages        bool
+	DefaultTagsConfig                  *tftags.DefaultConfig
+	DefaultTimeouts                    []DefaultTimeouts
+	EC2MetadataServiceEnableState      imds.ClientEnableState
+	EC2MetadataServiceEndpoint         string
+	EC2MetadataServiceEndpointMode     string
+	Endpoints                          map[string]string
+	ForbiddenAccountIds                []string
+	HTTPProxy                          *string
+	HTTPSProxy                         *string
+	IAMPolicyRecordingPath             string
+	IgnoreTagsConfig                   *tftags.IgnoreConfig
+	Insecure                           bool
+	MaxRetries                         int
+	NoProxy                            string
+	Profile                            string
+	Region                             string
+	RetryMode                          aws.RetryMode
+	S3UsePathStyle                     bool
+	S3USEast1RegionalEndpoint          string
+	SecretKey                          string
+	ServiceAvailabilityPreflightConfig *ServiceAvailabilityPreflightConfig
+	ServiceQuotaPreflightConfig        *ServiceQuotaPreflightConfig
+	SharedConfigFiles                  []string
+	SharedCredentialsFiles             []string
+	SkipCredsValidation                bool
+	SkipRegionValidation               bool
+	SkipRequestingAccountId            bool
+	STSRegion                          string
+	SuppressDebugLog                   bool
+	TerraformVersion                   string
+	Token                              string
+	TokenBucketRateLimiterCapacity     int
+	UseDualStackEndpoint               bool
+	UseFIPSEndpoint                    bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -156,7 +162,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
-	if !c.SkipRegionValidation {
+	if p := c.CustomPartition; p != nil {
+		// Regions in a custom partition aren't in the AWS SDK's endpoint metadata.
+		if !p.containsRegion(cfg.Region) {
+			return nil, sdkdiag.AppendErrorf(diags, "region %q is not in custom partition %q (region_regex %q)", cfg.Region, p.ID, p.RegionRegex.String())
+		}
+
+		cfg.APIOptions = append(cfg.APIOptions, p.apiOption(cfg.Region, c.UseDualStackEndpoint, c.UseFIPSEndpoint))
+	} else if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
@@ -208,12 +221,29 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.customPartition = c.CustomPartition
+	client.decodeAuthorizationMessages = c.DecodeAuthorizationMessages
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.defaultTimeouts = c.DefaultTimeouts
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
+	client.serviceAvailabilityPreflightConfig = c.ServiceAvailabilityPreflightConfig
+	client.serviceQuotaPreflightConfig = c.ServiceQuotaPreflightConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
+	if c.IAMPolicyRecordingPath != "" {
+		recorder, err := NewIAMActionRecorder(c.IAMPolicyRecordingPath, client.Partition(ctx))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		tflog.Info(ctx, "Recording IAM actions", map[string]any{
+			"path": c.IAMPolicyRecordingPath,
+		})
+		cfg.APIOptions = append(cfg.APIOptions, recorder.APIOption)
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
@@ -40,6 +40,7 @@ type InContext struct {
 	IsEphemeralResource bool   // Ephemeral resource?
 	ResourceName        string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName  string // Canonical name defined as a constant in names package
+	TypeName            string // Resource type name, e.g. "aws_subnet"
 }
 
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
@@ -62,10 +63,11 @@ func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resour
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
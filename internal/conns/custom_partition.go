@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+// Keys of the Route 53 hosted zone IDs of a custom partition.
+const (
+	HostedZoneIDKeyALB               = "alb"
+	HostedZoneIDKeyAppRunner         = "apprunner"
+	HostedZoneIDKeyCloudFront        = "cloudfront"
+	HostedZoneIDKeyELB               = "elb"
+	HostedZoneIDKeyGlobalAccelerator = "globalaccelerator"
+	HostedZoneIDKeyNLB               = "nlb"
+	HostedZoneIDKeyS3Website         = "s3_website"
+)
+
+// HostedZoneIDKeys returns the keys of the Route 53 hosted zone IDs of a custom partition.
+func HostedZoneIDKeys() []string {
+	return []string{
+		HostedZoneIDKeyALB,
+		HostedZoneIDKeyAppRunner,
+		HostedZoneIDKeyCloudFront,
+		HostedZoneIDKeyELB,
+		HostedZoneIDKeyGlobalAccelerator,
+		HostedZoneIDKeyNLB,
+		HostedZoneIDKeyS3Website,
+	}
+}
+
+const (
+	// defaultDNSSuffix is the domain suffix of the AWS Standard partition,
+	// used by the AWS SDK for Regions that aren't in any partition in its endpoint metadata.
+	defaultDNSSuffix = "amazonaws.com"
+	// defaultDualStackDNSSuffix is the dual-stack domain suffix of the AWS Standard partition.
+	defaultDualStackDNSSuffix = "api.aws"
+)
+
+// CustomPartition is a user-defined AWS partition, such as an AWS-compatible environment
+// or an isolated Region not yet in the AWS SDK's endpoint metadata.
+type CustomPartition struct {
+	ID                 string
+	RegionRegex        *regexp.Regexp
+	DNSSuffix          string
+	DualStackDNSSuffix string            // Defaults to DNSSuffix.
+	FIPSDNSSuffix      string            // Defaults to DNSSuffix.
+	HostedZoneIDs      map[string]string // Route 53 hosted zone IDs, keyed by HostedZoneIDKey*.
+}
+
+// containsRegion returns whether the specified AWS Region is in the partition.
+func (p *CustomPartition) containsRegion(region string) bool {
+	return p.RegionRegex == nil || p.RegionRegex.MatchString(region)
+}
+
+// dnsSuffix returns the domain suffix of service endpoints in the partition.
+func (p *CustomPartition) dnsSuffix(useDualStack, useFIPS bool) string {
+	switch {
+	case useDualStack && p.DualStackDNSSuffix != "":
+		return p.DualStackDNSSuffix
+	case useFIPS && p.FIPSDNSSuffix != "":
+		return p.FIPSDNSSuffix
+	default:
+		return p.DNSSuffix
+	}
+}
+
+// endpointHostnameRewriter returns a function that rewrites the hostname of a service endpoint
+// resolved by the AWS SDK for the specified Region to use the partition's domain suffixes.
+// The AWS SDK resolves endpoints using the domain suffixes of the partition in its endpoint metadata
+// whose Region regex matches the Region or, if none, the AWS Standard partition.
+func (p *CustomPartition) endpointHostnameRewriter(region string, useDualStack, useFIPS bool) func(string) string {
+	sdkDNSSuffixes := []string{defaultDNSSuffix, defaultDualStackDNSSuffix}
+	if v, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		sdkDNSSuffixes = append([]string{v.DNSSuffix()}, sdkDNSSuffixes...)
+	}
+	dnsSuffix := p.dnsSuffix(useDualStack, useFIPS)
+
+	return func(hostname string) string {
+		for _, v := range sdkDNSSuffixes {
+			if prefix, ok := strings.CutSuffix(hostname, "."+v); ok {
+				return prefix + "." + dnsSuffix
+			}
+		}
+
+		return hostname
+	}
+}
+
+// apiOption returns an AWS SDK for Go v2 API option that rewrites the hostnames of resolved service endpoints
+// to use the partition's domain suffixes.
+func (p *CustomPartition) apiOption(region string, useDualStack, useFIPS bool) func(*middleware.Stack) error {
+	rewrite := p.endpointHostnameRewriter(region, useDualStack, useFIPS)
+
+	return func(stack *middleware.Stack) error {
+		const resolveEndpointMiddlewareID = "ResolveEndpointV2"
+		if _, ok := stack.Finalize.Get(resolveEndpointMiddlewareID); !ok {
+			return nil
+		}
+
+		return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("CustomPartitionEndpoint", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if req, ok := in.Request.(*smithyhttp.Request); ok {
+				req.URL.Host = rewrite(req.URL.Host)
+			}
+
+			return next.HandleFinalize(ctx, in)
+		}), resolveEndpointMiddlewareID, middleware.After)
+	}
+}
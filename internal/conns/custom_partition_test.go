@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestCustomPartitionEndpointHostnameRewriter(t *testing.T) {
+	t.Parallel()
+
+	partition := &CustomPartition{
+		ID:                 "aws-example",
+		RegionRegex:        regexache.MustCompile(`^example-\w+-\d+$`),
+		DNSSuffix:          "cloud.example.com",
+		DualStackDNSSuffix: "api.cloud.example.com",
+	}
+
+	testCases := []struct {
+		Name         string
+		Region       string
+		UseDualStack bool
+		UseFIPS      bool
+		Hostname     string
+		Expected     string
+	}{
+		{
+			Name:     "regional",
+			Region:   "example-east-1",
+			Hostname: "ec2.example-east-1.amazonaws.com",
+			Expected: "ec2.example-east-1.cloud.example.com",
+		},
+		{
+			Name:     "global",
+			Region:   "example-east-1",
+			Hostname: "iam.amazonaws.com",
+			Expected: "iam.cloud.example.com",
+		},
+		{
+			Name:         "dual-stack",
+			Region:       "example-east-1",
+			UseDualStack: true,
+			Hostname:     "ec2.example-east-1.api.aws",
+			Expected:     "ec2.example-east-1.api.cloud.example.com",
+		},
+		{
+			Name:     "FIPS defaults to dns_suffix",
+			Region:   "example-east-1",
+			UseFIPS:  true,
+			Hostname: "ec2-fips.example-east-1.amazonaws.com",
+			Expected: "ec2-fips.example-east-1.cloud.example.com",
+		},
+		{
+			Name:     "custom endpoint",
+			Region:   "example-east-1",
+			Hostname: "localhost:4566",
+			Expected: "localhost:4566",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := partition.endpointHostnameRewriter(testCase.Region, testCase.UseDualStack, testCase.UseFIPS)(testCase.Hostname)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientCustomPartitionHostedZoneID(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	client := &AWSClient{
+		customPartition: &CustomPartition{
+			ID:          "aws-example",
+			RegionRegex: regexache.MustCompile(`^example-\w+-\d+$`),
+			DNSSuffix:   "cloud.example.com",
+			HostedZoneIDs: map[string]string{
+				HostedZoneIDKeyCloudFront: "Z0EXAMPLECF",
+				HostedZoneIDKeyELB:        "Z0EXAMPLEELB",
+			},
+		},
+		partition: standardPartition,
+		region:    "example-east-1",
+	}
+
+	if got, ok := client.CustomPartitionHostedZoneID(ctx, "example-east-1", HostedZoneIDKeyELB); !ok || got != "Z0EXAMPLEELB" {
+		t.Errorf("got %s, %t, expected Z0EXAMPLEELB, true", got, ok)
+	}
+	if got, ok := client.CustomPartitionHostedZoneID(ctx, "example-east-1", HostedZoneIDKeyNLB); ok {
+		t.Errorf("got %s, %t, expected no hosted zone ID", got, ok)
+	}
+	if got, ok := client.CustomPartitionHostedZoneID(ctx, "us-west-2", HostedZoneIDKeyELB); ok { //lintignore:AWSAT003
+		t.Errorf("got %s, %t, expected no hosted zone ID outside the partition", got, ok)
+	}
+	if got, expected := client.CloudFrontDistributionHostedZoneID(ctx), "Z0EXAMPLECF"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+	if got, expected := client.Partition(ctx), "aws-example"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
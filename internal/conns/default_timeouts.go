@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DefaultTimeouts are provider-level default operation timeouts for resource types,
+// overriding resources' compiled-in defaults. Zero durations are not set.
+type DefaultTimeouts struct {
+	// ResourceType is a resource type name, e.g. `aws_db_instance`, or a pattern with wildcards, e.g. `aws_rds_*`.
+	ResourceType string
+	Create       time.Duration
+	Read         time.Duration
+	Update       time.Duration
+	Delete       time.Duration
+}
+
+// specificity orders matching patterns: exact resource types before patterns, then by the number of literal characters.
+func (t DefaultTimeouts) specificity() int {
+	if !strings.ContainsAny(t.ResourceType, `*?[\`) {
+		return len(t.ResourceType) + 1<<16
+	}
+
+	return len(t.ResourceType) - strings.Count(t.ResourceType, "*") - strings.Count(t.ResourceType, "?")
+}
+
+// resolveDefaultTimeouts returns the default timeouts for the specified resource type,
+// each operation's timeout being taken from the most specific matching entry that sets it.
+func resolveDefaultTimeouts(defaultTimeouts []DefaultTimeouts, resourceType string) (*DefaultTimeouts, bool) {
+	var matches []DefaultTimeouts
+
+	for _, v := range defaultTimeouts {
+		if ok, _ := path.Match(v.ResourceType, resourceType); ok {
+			matches = append(matches, v)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, false
+	}
+
+	slices.SortStableFunc(matches, func(a, b DefaultTimeouts) int {
+		return a.specificity() - b.specificity()
+	})
+
+	result := &DefaultTimeouts{
+		ResourceType: resourceType,
+	}
+	for _, v := range matches {
+		if v.Create > 0 {
+			result.Create = v.Create
+		}
+		if v.Read > 0 {
+			result.Read = v.Read
+		}
+		if v.Update > 0 {
+			result.Update = v.Update
+		}
+		if v.Delete > 0 {
+			result.Delete = v.Delete
+		}
+	}
+
+	return result, true
+}
+
+type defaultTimeoutsContextKeyType int
+
+var defaultTimeoutsContextKey defaultTimeoutsContextKeyType
+
+// NewDefaultTimeoutsContext returns a Context carrying the resource's provider-level default timeouts.
+func NewDefaultTimeoutsContext(ctx context.Context, v *DefaultTimeouts) context.Context {
+	return context.WithValue(ctx, defaultTimeoutsContextKey, v)
+}
+
+// DefaultTimeoutsFromContext returns the resource's provider-level default timeouts carried by the Context, if any.
+func DefaultTimeoutsFromContext(ctx context.Context) (*DefaultTimeouts, bool) {
+	v, ok := ctx.Value(defaultTimeoutsContextKey).(*DefaultTimeouts)
+	return v, ok
+}
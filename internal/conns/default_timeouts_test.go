@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolveDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	defaultTimeouts := []DefaultTimeouts{
+		{
+			ResourceType: "aws_db_instance",
+			Create:       4 * time.Hour,
+		},
+		{
+			ResourceType: "aws_*",
+			Create:       time.Hour,
+			Delete:       time.Hour,
+		},
+		{
+			ResourceType: "aws_db_*",
+			Create:       2 * time.Hour,
+			Update:       2 * time.Hour,
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		ResourceType string
+		Expected     *DefaultTimeouts
+	}{
+		{
+			Name:         "exact",
+			ResourceType: "aws_db_instance",
+			Expected: &DefaultTimeouts{
+				ResourceType: "aws_db_instance",
+				Create:       4 * time.Hour,
+				Update:       2 * time.Hour,
+				Delete:       time.Hour,
+			},
+		},
+		{
+			Name:         "pattern",
+			ResourceType: "aws_db_snapshot",
+			Expected: &DefaultTimeouts{
+				ResourceType: "aws_db_snapshot",
+				Create:       2 * time.Hour,
+				Update:       2 * time.Hour,
+				Delete:       time.Hour,
+			},
+		},
+		{
+			Name:         "catch-all",
+			ResourceType: "aws_vpc",
+			Expected: &DefaultTimeouts{
+				ResourceType: "aws_vpc",
+				Create:       time.Hour,
+				Delete:       time.Hour,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := resolveDefaultTimeouts(defaultTimeouts, testCase.ResourceType)
+
+			if !ok {
+				t.Fatal("expected default timeouts")
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+
+	if _, ok := resolveDefaultTimeouts(defaultTimeouts[2:], "aws_vpc"); ok {
+		t.Error("expected no default timeouts")
+	}
+}
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// IAMPolicyRecordingPathEnvVar is the environment variable that enables IAM action recording
+	// when the provider's `iam_policy_recording_path` argument isn't set.
+	IAMPolicyRecordingPathEnvVar = "TF_AWS_IAM_POLICY_RECORDING_PATH"
+)
+
+// iamActionPrefixes maps AWS SDK for Go v2 service IDs to IAM service prefixes where the prefix
+// isn't the lower-cased service ID without spaces.
+var iamActionPrefixes = map[string]string{
+	"ACM PCA":                         "acm-pca",
+	"ApiGatewayV2":                    "apigateway",
+	"Application Auto Scaling":        "application-autoscaling",
+	"Bedrock Agent Runtime":           "bedrock",
+	"Bedrock Agent":                   "bedrock",
+	"Bedrock Runtime":                 "bedrock",
+	"CloudHSM V2":                     "cloudhsm",
+	"CloudWatch Events":               "events",
+	"CloudWatch Logs":                 "logs",
+	"CodeStar connections":            "codestar-connections",
+	"Cognito Identity Provider":       "cognito-idp",
+	"Cognito Identity":                "cognito-identity",
+	"Config Service":                  "config",
+	"Directory Service":               "ds",
+	"DocDB":                           "rds",
+	"EFS":                             "elasticfilesystem",
+	"Elastic Load Balancing v2":       "elasticloadbalancing",
+	"Elastic Load Balancing":          "elasticloadbalancing",
+	"Elasticsearch Service":           "es",
+	"EMR":                             "elasticmapreduce",
+	"EventBridge":                     "events",
+	"IoT Data Plane":                  "iot",
+	"Lex Model Building Service":      "lex",
+	"Lex Models V2":                   "lex",
+	"Marketplace Catalog":             "aws-marketplace",
+	"MWAA":                            "airflow",
+	"Neptune":                         "rds",
+	"Network Firewall":                "network-firewall",
+	"OpenSearch":                      "es",
+	"Resource Groups Tagging API":     "tag",
+	"Route53 Recovery Control Config": "route53-recovery-control-config",
+	"Service Catalog AppRegistry":     "servicecatalog",
+	"SESv2":                           "ses",
+	"SFN":                             "states",
+	"SSO Admin":                       "sso",
+	"Timestream Query":                "timestream",
+	"Timestream Write":                "timestream",
+	"VPC Lattice":                     "vpc-lattice",
+}
+
+// iamActionNames maps IAM-prefixed AWS API operation names to IAM action names where they differ.
+var iamActionNames = map[string]string{
+	"lambda:Invoke":                      "lambda:InvokeFunction",
+	"s3:CompleteMultipartUpload":         "s3:PutObject",
+	"s3:CopyObject":                      "s3:PutObject",
+	"s3:CreateMultipartUpload":           "s3:PutObject",
+	"s3:DeleteObjects":                   "s3:DeleteObject",
+	"s3:GetBucketLifecycleConfiguration": "s3:GetLifecycleConfiguration",
+	"s3:GetObjectAttributes":             "s3:GetObject",
+	"s3:HeadBucket":                      "s3:ListBucket",
+	"s3:HeadObject":                      "s3:GetObject",
+	"s3:ListMultipartUploads":            "s3:ListBucketMultipartUploads",
+	"s3:ListObjectVersions":              "s3:ListBucketVersions",
+	"s3:ListObjects":                     "s3:ListBucket",
+	"s3:ListObjectsV2":                   "s3:ListBucket",
+	"s3:PutBucketLifecycleConfiguration": "s3:PutLifecycleConfiguration",
+	"s3:UploadPart":                      "s3:PutObject",
+	"s3:UploadPartCopy":                  "s3:PutObject",
+}
+
+// iamAction returns the IAM action corresponding to the specified AWS SDK for Go v2 service ID and operation name.
+func iamAction(serviceID, operationName string) string {
+	prefix, ok := iamActionPrefixes[serviceID]
+	if !ok {
+		prefix = strings.ToLower(strings.ReplaceAll(serviceID, " ", ""))
+	}
+
+	action := prefix + ":" + operationName
+	if v, ok := iamActionNames[action]; ok {
+		return v
+	}
+
+	return action
+}
+
+// iamResources returns the ARNs of the resources acted on by the specified API operation input, where derivable.
+// ARNs are derived from S3 bucket names and object keys and from the `ResourceArn`, `Arn` or `<Noun>Arn` string field
+// of an operation named `<Verb><Noun>`, e.g. `LoadBalancerArn` for `DeleteLoadBalancer`.
+// Other ARNs in the input, e.g. `PolicyArn` for `AttachRolePolicy`, identify related resources rather than
+// the resource that the action is authorized on.
+func iamResources(partition, action string, input any) []string {
+	v := reflect.Indirect(reflect.ValueOf(input))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	field := func(name string) string {
+		if f := v.FieldByName(name); f.IsValid() {
+			if f = reflect.Indirect(f); f.Kind() == reflect.String {
+				return f.String()
+			}
+		}
+		return ""
+	}
+
+	prefix, operationName, _ := strings.Cut(action, ":")
+
+	if prefix == "s3" && partition != "" {
+		if bucket := field("Bucket"); bucket != "" && !arn.IsARN(bucket) {
+			if key := field("Key"); key != "" {
+				return []string{fmt.Sprintf("arn:%s:s3:::%s/%s", partition, bucket, key)}
+			}
+			return []string{fmt.Sprintf("arn:%s:s3:::%s", partition, bucket)}
+		}
+	}
+
+	names := []string{"ResourceArn", "Arn"}
+	if i := strings.IndexFunc(operationName[min(1, len(operationName)):], unicode.IsUpper); i >= 0 {
+		noun := operationName[i+1:]
+		names = append(names, noun+"Arn", noun+"ARN")
+	}
+
+	for _, name := range names {
+		s := field(name)
+		if s == "" {
+			continue
+		}
+
+		if arn, err := arn.Parse(s); err == nil && arn.Service == prefix {
+			return []string{s}
+		}
+	}
+
+	return nil
+}
+
+// IAMActionRecorder records the IAM actions, and the resources acted on where derivable, of all AWS API calls made
+// by AWS SDK for Go v2 API clients, writing an aggregated IAM policy document to a file.
+type IAMActionRecorder struct {
+	actions   map[string]map[string]struct{} // IAM action => resource ARNs.
+	lock      sync.Mutex
+	partition string
+	path      string
+}
+
+// NewIAMActionRecorder returns a new IAMActionRecorder writing to the specified file.
+// The AWS partition is used to derive S3 resource ARNs.
+// Actions and resources in any existing policy document in the file are retained,
+// so that a single policy document can be recorded across the plan, apply and destroy of a configuration.
+func NewIAMActionRecorder(path, partition string) (*IAMActionRecorder, error) {
+	r := &IAMActionRecorder{
+		actions:   make(map[string]map[string]struct{}),
+		partition: partition,
+		path:      path,
+	}
+
+	if err := r.read(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// read adds the actions and resources in any existing policy document in the file.
+func (r *IAMActionRecorder) read() error {
+	b, err := os.ReadFile(r.path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading IAM policy document (%s): %w", r.path, err)
+	}
+
+	var policy iamPolicyDocument
+	if err := json.Unmarshal(b, &policy); err != nil {
+		return fmt.Errorf("parsing IAM policy document (%s): %w", r.path, err)
+	}
+
+	for _, statement := range policy.Statement {
+		for _, action := range statement.Action {
+			for _, resource := range statement.Resource {
+				r.add(action, resource)
+			}
+		}
+	}
+
+	return nil
+}
+
+// APIOption adds the recording middleware to an AWS SDK for Go v2 API client's middleware stack.
+func (r *IAMActionRecorder) APIOption(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("RecordIAMAction", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if serviceID, operationName := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx); serviceID != "" && operationName != "" {
+			if err := r.Record(serviceID, operationName, in.Parameters); err != nil {
+				tflog.Warn(ctx, "recording IAM action", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
+
+// Record records the IAM action and resources of the specified API operation,
+// writing the policy document if the action or any resource hasn't been recorded before.
+func (r *IAMActionRecorder) Record(serviceID, operationName string, input any) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	action := iamAction(serviceID, operationName)
+	resources := iamResources(r.partition, action, input)
+	if len(resources) == 0 {
+		resources = []string{"*"}
+	}
+
+	var changed bool
+	for _, resource := range resources {
+		if r.add(action, resource) {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return r.write()
+}
+
+func (r *IAMActionRecorder) add(action, resource string) bool {
+	resources, ok := r.actions[action]
+	if !ok {
+		resources = make(map[string]struct{})
+		r.actions[action] = resources
+	}
+
+	if _, ok := resources[resource]; ok {
+		return false
+	}
+
+	resources[resource] = struct{}{}
+
+	return true
+}
+
+// write writes the policy document.
+// Terraform runs a provider process for each provider configuration, so the actions recorded by other processes
+// sharing the file are merged in, under a lock, before it is replaced.
+func (r *IAMActionRecorder) write() error {
+	unlock, err := lockFile(r.path + ".lock")
+	if err != nil {
+		return fmt.Errorf("locking IAM policy document (%s): %w", r.path, err)
+	}
+	defer unlock()
+
+	if err := r.read(); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(r.policyDocument(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename, so that the policy document is never partially written.
+	f, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), r.path)
+}
+
+const (
+	lockFileRetryInterval = 10 * time.Millisecond
+	lockFileTimeout       = 10 * time.Second
+	// lockFileStaleAge is the age after which a lock file is assumed to have been left by a process that exited while holding it.
+	lockFileStaleAge = time.Minute
+)
+
+// lockFile acquires an advisory lock by exclusively creating the specified lock file,
+// returning a function that releases the lock.
+func lockFile(path string) (func(), error) {
+	deadline := time.Now().Add(lockFileTimeout)
+
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+
+		if err == nil {
+			f.Close()
+
+			return func() { os.Remove(path) }, nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if fi, err := os.Stat(path); err == nil && time.Since(fi.ModTime()) > lockFileStaleAge {
+			os.Remove(path)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timeout waiting for lock file (%s)", path)
+		}
+
+		time.Sleep(lockFileRetryInterval)
+	}
+}
+
+type iamPolicyDocument struct {
+	Version   string
+	Statement []iamPolicyStatement
+}
+
+type iamPolicyStatement struct {
+	Effect   string
+	Action   []string
+	Resource []string
+}
+
+// policyDocument returns the recorded actions as an IAM policy document.
+// The ARNs of similar resources are grouped into wildcard patterns and
+// actions on the same resources are combined into a single statement.
+func (r *IAMActionRecorder) policyDocument() iamPolicyDocument {
+	statements := make(map[string]*iamPolicyStatement) // Resources => statement.
+
+	for action, resources := range r.actions {
+		patterns := iamResourcePatterns(resources)
+		key := strings.Join(patterns, "\n")
+
+		statement, ok := statements[key]
+		if !ok {
+			statement = &iamPolicyStatement{
+				Effect:   "Allow",
+				Resource: patterns,
+			}
+			statements[key] = statement
+		}
+
+		statement.Action = append(statement.Action, action)
+	}
+
+	policy := iamPolicyDocument{
+		Version: "2012-10-17",
+	}
+	for _, statement := range statements {
+		slices.Sort(statement.Action)
+		policy.Statement = append(policy.Statement, *statement)
+	}
+	slices.SortFunc(policy.Statement, func(a, b iamPolicyStatement) int {
+		return strings.Compare(a.Action[0], b.Action[0])
+	})
+
+	return policy
+}
+
+// iamResourcePatterns groups resource ARNs that differ only in their final path or ID component,
+// e.g. `arn:aws:ec2:us-west-2:123456789012:instance/i-1` and `arn:aws:ec2:us-west-2:123456789012:instance/i-2`,
+// into a wildcard pattern, e.g. `arn:aws:ec2:us-west-2:123456789012:instance/*`.
+func iamResourcePatterns(resources map[string]struct{}) []string {
+	if _, ok := resources["*"]; ok {
+		return []string{"*"}
+	}
+
+	groups := make(map[string][]string) // Pattern => resources.
+	for resource := range resources {
+		pattern := resource
+		if arn, err := arn.Parse(resource); err == nil {
+			if i := strings.LastIndexAny(arn.Resource, "/:"); i >= 0 {
+				arn.Resource = arn.Resource[:i+1] + "*"
+				pattern = arn.String()
+			}
+		}
+		groups[pattern] = append(groups[pattern], resource)
+	}
+
+	var patterns []string
+	for pattern, resources := range groups {
+		if len(resources) == 1 {
+			patterns = append(patterns, resources[0])
+		} else {
+			patterns = append(patterns, pattern)
+		}
+	}
+	slices.Sort(patterns)
+
+	return slices.Compact(patterns)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+)
+
+type testIAMPolicyDocument struct {
+	Version   string
+	Statement []struct {
+		Effect   string
+		Action   []string
+		Resource []string
+	}
+}
+
+func TestIAMActionRecorder(t *testing.T) {
+	t.Parallel()
+
+	type describeInstancesInput struct {
+		InstanceIds []string
+	}
+	type getObjectInput struct {
+		Bucket *string
+		Key    *string
+	}
+	type getRoleInput struct {
+		RoleName *string
+	}
+	type describeTargetGroupsInput struct {
+		TargetGroupArns []string
+		LoadBalancerArn *string
+	}
+	type deleteLoadBalancerInput struct {
+		LoadBalancerArn *string
+	}
+	type attachRolePolicyInput struct {
+		PolicyArn *string
+		RoleName  *string
+	}
+	type invokeInput struct {
+		FunctionName *string
+	}
+	type getParameterInput struct {
+		Name *string
+	}
+	type getFunctionInput struct {
+		FunctionArn *string
+	}
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+
+	recorder, err := conns.NewIAMActionRecorder(path, "aws")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		serviceID, operationName string
+		input                    any
+	}{
+		{"EC2", "DescribeInstances", &describeInstancesInput{InstanceIds: []string{"i-1"}}},
+		{"EC2", "DescribeInstances", &describeInstancesInput{InstanceIds: []string{"i-2"}}},
+		{"S3", "HeadObject", &getObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")}},
+		{"S3", "GetObject", &getObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")}},
+		{"S3", "ListObjectsV2", &getObjectInput{Bucket: aws.String("bucket")}},
+		{"IAM", "GetRole", &getRoleInput{RoleName: aws.String("example")}},
+		{"Elastic Load Balancing v2", "DescribeTargetGroups", &describeTargetGroupsInput{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/example/1234567890abcdef")}},
+		{"Elastic Load Balancing v2", "DeleteLoadBalancer", &deleteLoadBalancerInput{LoadBalancerArn: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/example/1234567890abcdef")}},
+		{"IAM", "AttachRolePolicy", &attachRolePolicyInput{PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"), RoleName: aws.String("example")}},
+		{"Lambda", "Invoke", &invokeInput{FunctionName: aws.String("example")}},
+		{"SSM", "GetParameter", &getParameterInput{Name: aws.String("example")}},
+		{"Lambda", "GetFunction", &getFunctionInput{FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:example1")}},
+		{"Lambda", "GetFunction", &getFunctionInput{FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:example2")}},
+		{"CloudWatch Logs", "DescribeLogGroups", nil},
+	} {
+		if err := recorder.Record(v.serviceID, v.operationName, v.input); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := testIAMPolicyDocument{
+		Version: "2012-10-17",
+	}
+	want.Statement = append(want.Statement, []struct {
+		Effect   string
+		Action   []string
+		Resource []string
+	}{
+		{"Allow", []string{"ec2:DescribeInstances", "elasticloadbalancing:DescribeTargetGroups", "iam:AttachRolePolicy", "iam:GetRole", "lambda:InvokeFunction", "logs:DescribeLogGroups", "ssm:GetParameter"}, []string{"*"}},
+		{"Allow", []string{"elasticloadbalancing:DeleteLoadBalancer"}, []string{"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/example/1234567890abcdef"}},
+		{"Allow", []string{"lambda:GetFunction"}, []string{"arn:aws:lambda:us-west-2:123456789012:function:*"}},
+		{"Allow", []string{"s3:GetObject"}, []string{"arn:aws:s3:::bucket/key"}},
+		{"Allow", []string{"s3:ListBucket"}, []string{"arn:aws:s3:::bucket"}},
+	}...)
+
+	got := readTestIAMPolicyDocument(t, path)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// A new recorder retains the recorded actions.
+	recorder, err = conns.NewIAMActionRecorder(path, "aws")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := recorder.Record("Lambda", "GetFunction", &getFunctionInput{FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:example3")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := recorder.Record("STS", "GetCallerIdentity", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want.Statement[0].Action = append(want.Statement[0].Action, "sts:GetCallerIdentity")
+
+	got = readTestIAMPolicyDocument(t, path)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestIAMActionRecorder_concurrent(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+
+	// Each provider configuration records to the same file from its own process.
+	const n = 4
+	recorders := make([]*conns.IAMActionRecorder, n)
+	for i := range recorders {
+		recorder, err := conns.NewIAMActionRecorder(path, "aws")
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorders[i] = recorder
+	}
+
+	var wg sync.WaitGroup
+	var want []string
+	for i, recorder := range recorders {
+		for j := range 10 {
+			operationName := fmt.Sprintf("Operation%d%d", i, j)
+			want = append(want, "ec2:"+operationName)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				if err := recorder.Record("EC2", operationName, nil); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	got := readTestIAMPolicyDocument(t, path)
+
+	if len(got.Statement) != 1 {
+		t.Fatalf("got %d statements, want 1", len(got.Statement))
+	}
+
+	slices.Sort(want)
+	if diff := cmp.Diff(got.Statement[0].Action, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func readTestIAMPolicyDocument(t *testing.T, path string) testIAMPolicyDocument {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var policy testIAMPolicyDocument
+	if err := json.Unmarshal(b, &policy); err != nil {
+		t.Fatal(err)
+	}
+
+	return policy
+}
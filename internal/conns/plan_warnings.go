@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+)
+
+// PlanWarning is a warning raised while planning a resource change.
+type PlanWarning struct {
+	Summary string
+	Detail  string
+}
+
+// PlanWarnings collects the warnings raised while planning a resource change.
+// Plugin SDK resources can't return warnings from CustomizeDiff, so the provider server
+// carries PlanWarnings in the planning Context and adds them to the plan's diagnostics.
+type PlanWarnings struct {
+	lock     sync.Mutex
+	warnings []PlanWarning
+}
+
+// All returns the collected warnings.
+func (w *PlanWarnings) All() []PlanWarning {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return append([]PlanWarning(nil), w.warnings...)
+}
+
+type planWarningsContextKeyType int
+
+var planWarningsContextKey planWarningsContextKeyType
+
+// NewPlanWarningsContext returns a Context carrying a new, empty PlanWarnings.
+func NewPlanWarningsContext(ctx context.Context) (context.Context, *PlanWarnings) {
+	v := &PlanWarnings{}
+
+	return context.WithValue(ctx, planWarningsContextKey, v), v
+}
+
+// AddPlanWarning adds a warning to the PlanWarnings carried by the Context.
+// It returns false if the Context carries none, e.g. outside of planning, so that the caller can log the warning instead.
+func AddPlanWarning(ctx context.Context, summary, detail string) bool {
+	v, ok := ctx.Value(planWarningsContextKey).(*PlanWarnings)
+	if !ok {
+		return false
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.warnings = append(v.warnings, PlanWarning{
+		Summary: summary,
+		Detail:  detail,
+	})
+
+	return true
+}
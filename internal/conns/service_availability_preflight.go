@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"slices"
+
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	ServiceAvailabilityPreflightActionError = "ERROR"
+	ServiceAvailabilityPreflightActionWarn  = "WARN"
+)
+
+// ServiceAvailabilityPreflightConfig configures the plan-time checks of planned resource creations
+// against the Regions in which each resource's service is available.
+type ServiceAvailabilityPreflightConfig struct {
+	// Action is ServiceAvailabilityPreflightActionWarn or ServiceAvailabilityPreflightActionError.
+	Action string
+	// Overrides maps service packages to additional Regions in which the service is available.
+	// A service with no Regions is treated as available in all Regions.
+	Overrides map[string][]string
+}
+
+// ServiceAvailable returns whether the service package is available in the given Region of the given partition.
+// known is false if the service's availability is unknown.
+func (c *ServiceAvailabilityPreflightConfig) ServiceAvailable(service, partition, region string) (available, known bool) {
+	if regions, ok := c.Overrides[service]; ok {
+		if len(regions) == 0 || slices.Contains(regions, region) {
+			return true, true
+		}
+	}
+
+	return names.ServiceAvailableInRegion(service, partition, region)
+}
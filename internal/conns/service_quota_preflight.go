@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+const (
+	ServiceQuotaPreflightActionError = "ERROR"
+	ServiceQuotaPreflightActionWarn  = "WARN"
+)
+
+// ServiceQuotaPreflightConfig configures the plan-time checks of planned resource creations against Service Quotas.
+type ServiceQuotaPreflightConfig struct {
+	// Action is ServiceQuotaPreflightActionWarn or ServiceQuotaPreflightActionError.
+	Action string
+	// Quotas are additional mappings from resource types to quotas, each planned resource consuming one unit.
+	Quotas []ServiceQuotaPreflightQuota
+}
+
+type ServiceQuotaPreflightQuota struct {
+	QuotaCode    string
+	ResourceType string
+	ServiceCode  string
+}
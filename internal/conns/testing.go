@@ -16,3 +16,8 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetDefaultTimeouts is only intended for use in tests
+func SetDefaultTimeouts(client *AWSClient, v []DefaultTimeouts) {
+	client.defaultTimeouts = v
+}
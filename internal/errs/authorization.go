@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+var (
+	// encodedAuthorizationMessageRegexp matches the encoded authorization failure message included in
+	// UnauthorizedOperation (and similar) errors returned by EC2 and several other services.
+	encodedAuthorizationMessageRegexp = regexache.MustCompile(`Encoded authorization failure message: ([0-9A-Za-z_-]+)`)
+	// explicitDenySourceRegexp matches the type of policy containing an explicit deny, e.g. "a service control policy".
+	explicitDenySourceRegexp = regexache.MustCompile(`with an explicit deny in an? ([a-z -]*policy)`)
+)
+
+// AuthorizationMessageDecoder decodes an encoded authorization failure message,
+// e.g. by calling STS DecodeAuthorizationMessage, returning the decoded JSON document.
+type AuthorizationMessageDecoder func(ctx context.Context, encodedMessage string) (string, error)
+
+// EncodedAuthorizationMessage returns the encoded authorization failure message contained in the specified error message, if any.
+func EncodedAuthorizationMessage(s string) (string, bool) {
+	m := encodedAuthorizationMessageRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return "", false
+	}
+
+	return m[1], true
+}
+
+// DecodeAuthorizationMessage decodes any encoded authorization failure message contained in the specified error message
+// and returns a description of the decoded message suitable for appending to a diagnostic's detail.
+// If decoding fails, the description explains why.
+func DecodeAuthorizationMessage(ctx context.Context, s string, decoder AuthorizationMessageDecoder) (string, bool) {
+	encodedMessage, ok := EncodedAuthorizationMessage(s)
+	if !ok || decoder == nil {
+		return "", false
+	}
+
+	decodedMessage, err := decoder(ctx, encodedMessage)
+	if err != nil {
+		return fmt.Sprintf("Decoding the authorization failure message failed: %s", err), true
+	}
+
+	description, err := DescribeDecodedAuthorizationMessage(decodedMessage, explicitDenySource(s))
+	if err != nil {
+		return fmt.Sprintf("Parsing the decoded authorization failure message failed: %s", err), true
+	}
+
+	return description, true
+}
+
+func explicitDenySource(s string) string {
+	if m := explicitDenySourceRegexp.FindStringSubmatch(s); m != nil {
+		return m[1]
+	}
+
+	return ""
+}
+
+type decodedAuthorizationMessage struct {
+	Allowed           bool `json:"allowed"`
+	ExplicitDeny      bool `json:"explicitDeny"`
+	MatchedStatements struct {
+		Items []struct {
+			StatementID string `json:"statementId"`
+			Effect      string `json:"effect"`
+			Actions     struct {
+				Items []authorizationMessageValue `json:"items"`
+			} `json:"actions"`
+			Resources struct {
+				Items []authorizationMessageValue `json:"items"`
+			} `json:"resources"`
+		} `json:"items"`
+	} `json:"matchedStatements"`
+	Context struct {
+		Principal struct {
+			ARN  string `json:"arn"`
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"principal"`
+		Action     string `json:"action"`
+		Resource   string `json:"resource"`
+		Conditions struct {
+			Items []struct {
+				Key    string `json:"key"`
+				Values struct {
+					Items []authorizationMessageValue `json:"items"`
+				} `json:"values"`
+			} `json:"items"`
+		} `json:"conditions"`
+	} `json:"context"`
+}
+
+type authorizationMessageValue struct {
+	Value string `json:"value"`
+}
+
+func authorizationMessageValues(items []authorizationMessageValue) string {
+	values := make([]string, len(items))
+	for i, v := range items {
+		values[i] = v.Value
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// DescribeDecodedAuthorizationMessage returns a description of the specified decoded authorization failure message,
+// as returned by STS DecodeAuthorizationMessage, including the action, resource, matched statements and explicit deny source.
+// explicitDenySource is the type of policy containing the explicit deny, if known.
+func DescribeDecodedAuthorizationMessage(decodedMessage, explicitDenySource string) (string, error) {
+	var m decodedAuthorizationMessage
+
+	if err := json.Unmarshal([]byte(decodedMessage), &m); err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+
+	fmt.Fprintln(&buf, "Decoded authorization failure message:")
+	if v := m.Context.Principal; v.ARN != "" {
+		fmt.Fprintf(&buf, "  Principal: %s\n", v.ARN)
+	} else if v.ID != "" {
+		fmt.Fprintf(&buf, "  Principal: %s\n", v.ID)
+	}
+	fmt.Fprintf(&buf, "  Action: %s\n", m.Context.Action)
+	fmt.Fprintf(&buf, "  Resource: %s\n", m.Context.Resource)
+	fmt.Fprintf(&buf, "  Allowed: %t\n", m.Allowed)
+	switch {
+	case m.ExplicitDeny && explicitDenySource != "":
+		fmt.Fprintf(&buf, "  Explicit deny: true (in a %s)\n", explicitDenySource)
+	default:
+		fmt.Fprintf(&buf, "  Explicit deny: %t\n", m.ExplicitDeny)
+	}
+
+	if items := m.MatchedStatements.Items; len(items) > 0 {
+		fmt.Fprintln(&buf, "  Matched statements:")
+		for _, v := range items {
+			fmt.Fprintf(&buf, "    - %s", v.Effect)
+			if v.StatementID != "" {
+				fmt.Fprintf(&buf, " (Sid %q)", v.StatementID)
+			}
+			fmt.Fprintf(&buf, ": actions [%s], resources [%s]\n", authorizationMessageValues(v.Actions.Items), authorizationMessageValues(v.Resources.Items))
+		}
+	} else if !m.ExplicitDeny {
+		fmt.Fprintln(&buf, "  Matched statements: none (implicit deny)")
+	}
+
+	if items := m.Context.Conditions.Items; len(items) > 0 {
+		fmt.Fprintln(&buf, "  Request context:")
+		for _, v := range items {
+			fmt.Fprintf(&buf, "    - %s: %s\n", v.Key, authorizationMessageValues(v.Values.Items))
+		}
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
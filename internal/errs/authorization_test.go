@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+)
+
+const testDecodedAuthorizationMessage = `{
+  "allowed": false,
+  "explicitDeny": true,
+  "matchedStatements": {
+    "items": [{
+      "statementId": "DenyRunInstances",
+      "effect": "DENY",
+      "actions": {"items": [{"value": "ec2:RunInstances"}]},
+      "resources": {"items": [{"value": "*"}]}
+    }]
+  },
+  "failures": {"items": []},
+  "context": {
+    "principal": {"id": "AIDACKCEVSQ6C2EXAMPLE", "name": "example", "arn": "arn:aws:iam::123456789012:user/example"},
+    "action": "ec2:RunInstances",
+    "resource": "arn:aws:ec2:us-west-2:123456789012:instance/*",
+    "conditions": {"items": [{"key": "aws:RequestedRegion", "values": {"items": [{"value": "us-west-2"}]}}]}
+  }
+}`
+
+func TestEncodedAuthorizationMessage(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s      string
+		want   string
+		wantOK bool
+	}{
+		"empty": {},
+		"no encoded message": {
+			s: "api error UnauthorizedOperation: You are not authorized to perform this operation.",
+		},
+		"encoded message": {
+			s:      "api error UnauthorizedOperation: You are not authorized to perform this operation. Encoded authorization failure message: AbC-12_3xYz",
+			want:   "AbC-12_3xYz",
+			wantOK: true,
+		},
+		"encoded message with trailing text": {
+			s:      "Encoded authorization failure message: AbC123\n\nwith lots of other details",
+			want:   "AbC123",
+			wantOK: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.EncodedAuthorizationMessage(testCase.s)
+
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("EncodedAuthorizationMessage = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDecodeAuthorizationMessage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	decoder := func(_ context.Context, encodedMessage string) (string, error) {
+		switch encodedMessage {
+		case "valid":
+			return testDecodedAuthorizationMessage, nil
+		case "invalid":
+			return "{", nil
+		}
+		return "", errors.New("access denied")
+	}
+
+	testCases := map[string]struct {
+		s       string
+		decoder errs.AuthorizationMessageDecoder
+		want    string
+		wantOK  bool
+	}{
+		"no encoded message": {
+			s:       "api error AccessDenied: Access Denied",
+			decoder: decoder,
+		},
+		"no decoder": {
+			s: "Encoded authorization failure message: valid",
+		},
+		"decoded": {
+			s:       "User: arn:aws:iam::123456789012:user/example is not authorized to perform: ec2:RunInstances with an explicit deny in a service control policy. Encoded authorization failure message: valid",
+			decoder: decoder,
+			want: `Decoded authorization failure message:
+  Principal: arn:aws:iam::123456789012:user/example
+  Action: ec2:RunInstances
+  Resource: arn:aws:ec2:us-west-2:123456789012:instance/*
+  Allowed: false
+  Explicit deny: true (in a service control policy)
+  Matched statements:
+    - DENY (Sid "DenyRunInstances"): actions [ec2:RunInstances], resources [*]
+  Request context:
+    - aws:RequestedRegion: us-west-2`,
+			wantOK: true,
+		},
+		"decoding error": {
+			s:       "Encoded authorization failure message: denied",
+			decoder: decoder,
+			want:    "Decoding the authorization failure message failed: access denied",
+			wantOK:  true,
+		},
+		"invalid decoded message": {
+			s:       "Encoded authorization failure message: invalid",
+			decoder: decoder,
+			want:    "Parsing the decoded authorization failure message failed: unexpected end of JSON input",
+			wantOK:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.DecodeAuthorizationMessage(ctx, testCase.s, testCase.decoder)
+
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("DecodeAuthorizationMessage = %q, want %q", got, want)
+			}
+		})
+	}
+}
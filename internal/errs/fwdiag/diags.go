@@ -4,11 +4,13 @@
 package fwdiag
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
 )
 
 // DiagnosticsError returns an error containing all Diagnostic with SeverityError
@@ -59,3 +61,38 @@ func DiagnosticsString(diags diag.Diagnostics) string {
 
 	return buf.String()
 }
+
+// AppendDecodedAuthorizationMessages appends a description of any encoded authorization failure message
+// in the summary or detail of each error Diagnostic to the Diagnostic's detail, using the specified decoder.
+func AppendDecodedAuthorizationMessages(ctx context.Context, diags diag.Diagnostics, decoder errs.AuthorizationMessageDecoder) diag.Diagnostics {
+	if decoder == nil || !diags.HasError() {
+		return diags
+	}
+
+	output := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		if d.Severity() != diag.SeverityError {
+			output = append(output, d)
+			continue
+		}
+
+		description, ok := errs.DecodeAuthorizationMessage(ctx, d.Summary()+"\n"+d.Detail(), decoder)
+		if !ok {
+			output = append(output, d)
+			continue
+		}
+
+		detail := description
+		if d.Detail() != "" {
+			detail = d.Detail() + "\n\n" + description
+		}
+
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			output = append(output, diag.NewAttributeErrorDiagnostic(withPath.Path(), d.Summary(), detail))
+		} else {
+			output = append(output, diag.NewErrorDiagnostic(d.Summary(), detail))
+		}
+	}
+
+	return output
+}
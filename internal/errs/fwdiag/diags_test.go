@@ -4,9 +4,11 @@
 package fwdiag_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/isometry/terraform-provider-faws/internal/errs/fwdiag"
 )
 
@@ -68,3 +70,55 @@ func TestDiagnosticsError(t *testing.T) {
 		})
 	}
 }
+
+func TestAppendDecodedAuthorizationMessages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	decoder := func(context.Context, string) (string, error) {
+		return `{"allowed": false, "explicitDeny": false, "context": {"action": "ec2:RunInstances", "resource": "*"}}`, nil
+	}
+	decoded := `Decoded authorization failure message:
+  Action: ec2:RunInstances
+  Resource: *
+  Allowed: false
+  Explicit deny: false
+  Matched statements: none (implicit deny)`
+
+	diags := diag.Diagnostics{
+		diag.NewWarningDiagnostic("summary", "Encoded authorization failure message: abc"),
+		diag.NewErrorDiagnostic("creating EC2 Instance", "Encoded authorization failure message: abc"),
+		diag.NewAttributeErrorDiagnostic(path.Root("name"), "creating EC2 Instance", "Encoded authorization failure message: abc"),
+		diag.NewErrorDiagnostic("creating EC2 Instance", "AccessDenied"),
+	}
+
+	testCases := map[string]struct {
+		decoder func(context.Context, string) (string, error)
+		want    diag.Diagnostics
+	}{
+		"no decoder": {
+			want: diags,
+		},
+		"decoder": {
+			decoder: decoder,
+			want: diag.Diagnostics{
+				diag.NewWarningDiagnostic("summary", "Encoded authorization failure message: abc"),
+				diag.NewErrorDiagnostic("creating EC2 Instance", "Encoded authorization failure message: abc\n\n"+decoded),
+				diag.NewAttributeErrorDiagnostic(path.Root("name"), "creating EC2 Instance", "Encoded authorization failure message: abc\n\n"+decoded),
+				diag.NewErrorDiagnostic("creating EC2 Instance", "AccessDenied"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwdiag.AppendDecodedAuthorizationMessages(ctx, diags, testCase.decoder)
+
+			if !got.Equal(testCase.want) {
+				t.Errorf("AppendDecodedAuthorizationMessages = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@
 package sdkdiag
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
 	tfslices "github.com/isometry/terraform-provider-faws/internal/slices"
 )
 
@@ -43,3 +45,30 @@ func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics
 		}
 	})
 }
+
+// AppendDecodedAuthorizationMessages appends a description of any encoded authorization failure message
+// in the summary or detail of each error Diagnostic to the Diagnostic's detail, using the specified decoder.
+func AppendDecodedAuthorizationMessages(ctx context.Context, diags diag.Diagnostics, decoder errs.AuthorizationMessageDecoder) diag.Diagnostics {
+	if decoder == nil {
+		return diags
+	}
+
+	return tfslices.ApplyToAll(diags, func(d diag.Diagnostic) diag.Diagnostic {
+		if d.Severity != diag.Error {
+			return d
+		}
+
+		description, ok := errs.DecodeAuthorizationMessage(ctx, d.Summary+"\n"+d.Detail, decoder)
+		if !ok {
+			return d
+		}
+
+		if d.Detail == "" {
+			d.Detail = description
+		} else {
+			d.Detail = d.Detail + "\n\n" + description
+		}
+
+		return d
+	})
+}
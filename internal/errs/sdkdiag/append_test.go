@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
+)
+
+func TestAppendDecodedAuthorizationMessages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	decoder := func(context.Context, string) (string, error) {
+		return `{"allowed": false, "explicitDeny": false, "context": {"action": "ec2:RunInstances", "resource": "*"}}`, nil
+	}
+	decoded := `Decoded authorization failure message:
+  Action: ec2:RunInstances
+  Resource: *
+  Allowed: false
+  Explicit deny: false
+  Matched statements: none (implicit deny)`
+
+	diags := diag.Diagnostics{
+		diag.Diagnostic{Severity: diag.Warning, Summary: "Encoded authorization failure message: abc"},
+		diag.Diagnostic{Severity: diag.Error, Summary: "creating EC2 Instance: Encoded authorization failure message: abc"},
+		diag.Diagnostic{Severity: diag.Error, Summary: "creating EC2 Instance", Detail: "Encoded authorization failure message: abc"},
+		diag.Diagnostic{Severity: diag.Error, Summary: "creating EC2 Instance", Detail: "AccessDenied"},
+	}
+
+	testCases := map[string]struct {
+		decoder func(context.Context, string) (string, error)
+		want    diag.Diagnostics
+	}{
+		"no decoder": {
+			want: diags,
+		},
+		"decoder": {
+			decoder: decoder,
+			want: diag.Diagnostics{
+				diag.Diagnostic{Severity: diag.Warning, Summary: "Encoded authorization failure message: abc"},
+				diag.Diagnostic{Severity: diag.Error, Summary: "creating EC2 Instance: Encoded authorization failure message: abc", Detail: decoded},
+				diag.Diagnostic{Severity: diag.Error, Summary: "creating EC2 Instance", Detail: "Encoded authorization failure message: abc\n\n" + decoded},
+				diag.Diagnostic{Severity: diag.Error, Summary: "creating EC2 Instance", Detail: "AccessDenied"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := sdkdiag.AppendDecodedAuthorizationMessages(ctx, diags, testCase.decoder)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -5,8 +5,11 @@ package flex
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -15,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
-	smithyjson "github.com/isometry/terraform-provider-faws/internal/json"
 )
 
 // Expand  = TF -->  AWS
@@ -195,6 +197,10 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 	case basetypes.SetValuable:
 		diags.Append(expander.set(ctx, sourcePath, vFrom, targetPath, vTo)...)
 		return diags
+
+	case basetypes.DynamicValuable:
+		diags.Append(expander.dynamic(ctx, vFrom, vTo)...)
+		return diags
 	}
 
 	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -485,14 +491,34 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		}
 
 	case reflect.Interface:
-		if s, ok := vFrom.(fwtypes.SmithyJSON[smithyjson.JSONStringer]); ok {
-			v, d := s.ValueInterface()
+		//
+		// fwtypes.SmithyJSON -> Smithy document.
+		//
+		if s, ok := vFrom.(fwtypes.SmithyDocumentValuable); ok {
+			doc, d := s.ValueSmithyDocument()
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(v))
+			diags.Append(setSmithyDocument(doc, vTo)...)
+			return diags
+		}
+
+		//
+		// types.String (JSON) -> Smithy document.
+		//
+		if f, ok := expander.Options.smithyDocumentConstructor(tTo); ok {
+			var data any
+			if err := json.Unmarshal([]byte(v.ValueString()), &data); err != nil {
+				tflog.SubsystemError(ctx, subsystemName, "Unmarshalling JSON document", map[string]any{
+					logAttrKeyError: err.Error(),
+				})
+				diags.Append(diagExpandingUnmarshalJSONDocument(tTo, err))
+				return diags
+			}
+
+			diags.Append(setSmithyDocument(f(data), vTo)...)
 			return diags
 		}
 
@@ -536,6 +562,46 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 	return diags
 }
 
+// dynamic copies a Plugin Framework Dynamic(ish) value to a compatible AWS API value.
+func (expander autoExpander) dynamic(ctx context.Context, vFrom basetypes.DynamicValuable, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, d := vFrom.ToDynamicValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	switch tTo := vTo.Type(); vTo.Kind() {
+	case reflect.Interface:
+		//
+		// types.Dynamic -> Smithy document.
+		//
+		if f, ok := expander.Options.smithyDocumentConstructor(tTo); ok {
+			if v.IsUnderlyingValueNull() || v.IsUnderlyingValueUnknown() {
+				tflog.SubsystemTrace(ctx, subsystemName, "Expanding null value")
+				return diags
+			}
+
+			data, d := smithyDocumentValueFromFramework(ctx, v.UnderlyingValue())
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			diags.Append(setSmithyDocument(f(data), vTo)...)
+			return diags
+		}
+	}
+
+	tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]interface{}{
+		"from": vFrom.Type(ctx),
+		"to":   vTo.Kind(),
+	})
+
+	return diags
+}
+
 // string copies a Plugin Framework Object(ish) value to a compatible AWS API value.
 func (expander autoExpander) object(ctx context.Context, sourcePath path.Path, vFrom basetypes.ObjectValuable, targetPath path.Path, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -1260,6 +1326,102 @@ func expandTypedExpander(ctx context.Context, fromTypedExpander TypedExpander, t
 	return diags
 }
 
+// expandTaggedUnion copies the single set field of a tagged union model to the `Value` field of the corresponding union member.
+func expandTaggedUnion(ctx context.Context, sourcePath path.Path, fromTaggedUnion TaggedUnion, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom, typeTo := valFrom.Type(), valTo.Type()
+	opts := flexer.getOptions()
+
+	var setFields []reflect.StructField
+	for i := 0; i < typeFrom.NumField(); i++ {
+		field := typeFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if opts.isIgnoredField(field.Name) {
+			continue
+		}
+		if nameOverride, _ := autoflexTags(field); nameOverride == "-" {
+			continue
+		}
+
+		if v, ok := valFrom.Field(i).Interface().(attr.Value); ok && isUnionMemberSet(v) {
+			setFields = append(setFields, field)
+		}
+	}
+
+	switch len(setFields) {
+	case 0:
+		tflog.SubsystemTrace(ctx, subsystemName, "Expanding tagged union with no member set")
+		return diags
+
+	case 1:
+
+	default:
+		tflog.SubsystemError(ctx, subsystemName, "Expanding tagged union with multiple members set")
+		diags.Append(diagExpandingMultipleUnionMembers(setFields))
+		return diags
+	}
+
+	fromField := setFields[0]
+
+	var typeMember reflect.Type
+	for _, v := range fromTaggedUnion.UnionMembers() {
+		t := reflect.TypeOf(v)
+		if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct || !t.Implements(typeTo) {
+			continue
+		}
+		if field, ok := unionMemberField(t.Elem(), typeFrom, opts); ok && field.Name == fromField.Name {
+			typeMember = t.Elem()
+			break
+		}
+	}
+
+	if typeMember == nil {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding tagged union member", map[string]any{
+			logAttrKeySourceFieldname: fromField.Name,
+		})
+		diags.Append(diagExpandingNoUnionMember(typeFrom, fromField.Name, typeTo))
+		return diags
+	}
+
+	to := reflect.New(typeMember)
+	toFieldVal := to.Elem().FieldByName("Value")
+	if !toFieldVal.IsValid() || !toFieldVal.CanSet() {
+		diags.Append(diagExpandingNoUnionMember(typeFrom, fromField.Name, typeTo))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched tagged union member", map[string]any{
+		logAttrKeySourceFieldname: fromField.Name,
+		logAttrKeyTargetType:      fullTypeName(to.Type()),
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(fromField.Name), valFrom.FieldByIndex(fromField.Index), targetPath.AtName("Value"), toFieldVal, fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(to)
+
+	return diags
+}
+
+// isUnionMemberSet returns whether a tagged union model field has a value.
+// Nested blocks are never null, so empty collections are treated as not set.
+func isUnionMemberSet(v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(interface{ Elements() []attr.Value }); ok {
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
+
 func diagExpandingSourceIsNil(sourceType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -1329,3 +1491,36 @@ func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.Er
 			fmt.Sprintf("Source type %q cannot be expanded to target type %q.", fullTypeName(sourceType), fullTypeName(targetType)),
 	)
 }
+
+func diagExpandingUnmarshalJSONDocument(targetType reflect.Type, err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid JSON String Value",
+		fmt.Sprintf("Unmarshalling JSON document of type %q failed: %s", fullTypeName(targetType), err.Error()),
+	)
+}
+
+func diagExpandingMultipleUnionMembers(fields []reflect.StructField) diag.ErrorDiagnostic {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		name := field.Name
+		if v, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ","); v != "" {
+			name = v
+		}
+		names[i] = strconv.Quote(name)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Only one of the following attributes can be specified: %s.", strings.Join(names, ", ")),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceType reflect.Type, sourceFieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field %q of type %q does not correspond to a member of %q.", sourceFieldName, fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
@@ -6,6 +6,8 @@ package flex
 import (
 	"bytes"
 	"context"
+	"errors"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -4868,6 +4870,277 @@ func testFlexAWSInterfaceInterfacePtr(v awsInterfaceInterface) *awsInterfaceInte
 	return &v
 }
 
+func TestExpandTaggedUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"single list Source and single interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:  fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String:  types.StringValue("value1"),
+						Strings: fwtypes.NewListValueOfNull[types.String](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberString{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexTaggedUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceExpandingTaggedUnionMember("Field1[0]", "String", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionMemberString]()),
+				infoConvertingWithPath("Field1[0].String", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"single list Source with nested member and single interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("value1"),
+						}),
+						String:  types.StringNull(),
+						Strings: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberNested{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexTaggedUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceExpandingTaggedUnionMember("Field1[0]", "Nested", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionMemberNested]()),
+				infoConvertingWithPath("Field1[0].Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Nested[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Nested[0].Field1", reflect.TypeFor[types.String](), "Field1.Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"single list Source with no member and single interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:  fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String:  types.StringNull(),
+						Strings: fwtypes.NewListValueOfNull[types.String](ctx),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: nil,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexTaggedUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceExpandingTaggedUnionNoMember("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"single list Source with multiple members and single interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:  fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String:  types.StringValue("value1"),
+						Strings: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{types.StringValue("value2")}),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					`Only one of the following attributes can be specified: "string", "strings".`,
+				),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoSourceImplementsFlexTaggedUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				errorExpandingTaggedUnionMultipleMembers("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"list Source and slice of interface Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:  fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String:  types.StringValue("value1"),
+						Strings: fwtypes.NewListValueOfNull[types.String](ctx),
+					},
+					{
+						Nested:  fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String:  types.StringNull(),
+						Strings: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{types.StringValue("value2")}),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberString{
+						Value: "value1",
+					},
+					&awsUnionMemberStrings{
+						Value: []string{"value2"},
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnion]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnion]()),
+				infoSourceImplementsFlexTaggedUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnion]()),
+				traceExpandingTaggedUnionMember("Field1[0]", "String", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnionMemberString]()),
+				infoConvertingWithPath("Field1[0].String", reflect.TypeFor[types.String](), "Field1[0].Value", reflect.TypeFor[string]()),
+				infoSourceImplementsFlexTaggedUnion("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnion]()),
+				traceExpandingTaggedUnionMember("Field1[1]", "Strings", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnionMemberStrings]()),
+				infoConvertingWithPath("Field1[1].Strings", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Field1[1].Value", reflect.TypeFor[[]string]()),
+				traceExpandingWithElementsAs("Field1[1].Strings", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), 1, "Field1[1].Value", reflect.TypeFor[[]string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"JSON string Source": {
+			Options: []AutoFlexOptionsFunc{WithSmithyDocumentConstructor(newTestJSONDocument)},
+			Source:  tfSingleStringField{Field1: types.StringValue(`{"field1": "a"}`)},
+			Target:  &awsDocumentSingle{},
+			WantTarget: &awsDocumentSingle{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringField](), reflect.TypeFor[*awsDocumentSingle]()),
+				infoConverting(reflect.TypeFor[tfSingleStringField](), reflect.TypeFor[*awsDocumentSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringField](), "Field1", reflect.TypeFor[*awsDocumentSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+			},
+		},
+		"invalid JSON string Source": {
+			Options: []AutoFlexOptionsFunc{WithSmithyDocumentConstructor(newTestJSONDocument)},
+			Source:  tfSingleStringField{Field1: types.StringValue(`{`)},
+			Target:  &awsDocumentSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingUnmarshalJSONDocument(reflect.TypeFor[smithyjson.JSONStringer](), errors.New("unexpected end of JSON input")),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfSingleStringField](), reflect.TypeFor[*awsDocumentSingle]()),
+				infoConverting(reflect.TypeFor[tfSingleStringField](), reflect.TypeFor[*awsDocumentSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringField](), "Field1", reflect.TypeFor[*awsDocumentSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+				errorUnmarshallingJSONDocument("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[smithyjson.JSONStringer](), errors.New("unexpected end of JSON input")),
+			},
+		},
+		"dynamic Source": {
+			Options: []AutoFlexOptionsFunc{WithSmithyDocumentConstructor(newTestJSONDocument)},
+			Source: tfDynamicField{Field1: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"field1": types.StringType,
+					"field2": types.NumberType,
+					"field3": types.TupleType{ElemTypes: []attr.Type{types.BoolType, types.NumberType}},
+				},
+				map[string]attr.Value{
+					"field1": types.StringValue("a"),
+					"field2": types.NumberValue(big.NewFloat(42)),
+					"field3": types.TupleValueMust([]attr.Type{types.BoolType, types.NumberType}, []attr.Value{types.BoolValue(true), types.NumberValue(big.NewFloat(1.5))}),
+				},
+			))},
+			Target: &awsDocumentSingle{},
+			WantTarget: &awsDocumentSingle{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+						"field2": int64(42),
+						"field3": []any{true, float64(1.5)},
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfDynamicField](), reflect.TypeFor[*awsDocumentSingle]()),
+				infoConverting(reflect.TypeFor[tfDynamicField](), reflect.TypeFor[*awsDocumentSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfDynamicField](), "Field1", reflect.TypeFor[*awsDocumentSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.Dynamic](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+			},
+		},
+		"dynamic Source with null value": {
+			Options: []AutoFlexOptionsFunc{WithSmithyDocumentConstructor(newTestJSONDocument)},
+			Source:  tfDynamicField{Field1: types.DynamicValue(types.StringNull())},
+			Target:  &awsDocumentSingle{},
+			WantTarget: &awsDocumentSingle{
+				Field1: nil,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfDynamicField](), reflect.TypeFor[*awsDocumentSingle]()),
+				infoConverting(reflect.TypeFor[tfDynamicField](), reflect.TypeFor[*awsDocumentSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfDynamicField](), "Field1", reflect.TypeFor[*awsDocumentSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.Dynamic](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+				traceExpandingNullValue("Field1", reflect.TypeFor[types.Dynamic](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+			},
+		},
+		"dynamic Source without constructor": {
+			Source: tfDynamicField{Field1: types.DynamicValue(types.StringValue("a"))},
+			Target: &awsDocumentSingle{},
+			WantTarget: &awsDocumentSingle{
+				Field1: nil,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfDynamicField](), reflect.TypeFor[*awsDocumentSingle]()),
+				infoConverting(reflect.TypeFor[tfDynamicField](), reflect.TypeFor[*awsDocumentSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfDynamicField](), "Field1", reflect.TypeFor[*awsDocumentSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.Dynamic](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+				{
+					"@level":             "error",
+					"@module":            "provider.autoflex",
+					"@message":           "AutoFlex Expand; incompatible types",
+					"from":               map[string]any{},
+					"to":                 float64(reflect.Interface),
+					logAttrKeySourcePath: "Field1",
+					logAttrKeySourceType: fullTypeName(reflect.TypeFor[types.Dynamic]()),
+					logAttrKeyTargetPath: "Field1",
+					logAttrKeyTargetType: fullTypeName(reflect.TypeFor[smithyjson.JSONStringer]()),
+				},
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandExpander(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
 	smithyjson "github.com/isometry/terraform-provider-faws/internal/json"
@@ -190,7 +192,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -609,7 +611,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -649,11 +651,51 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 			return diags
 		}
 
+	case basetypes.DynamicTypable:
+		//
+		// JSONStringer -> types.Dynamic-ish.
+		//
+		if vFrom.Type().Implements(reflect.TypeFor[smithyjson.JSONStringer]()) {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements json.JSONStringer")
+
+			dynamicValue := types.DynamicNull()
+
+			if vFrom.IsNil() {
+				tflog.SubsystemTrace(ctx, subsystemName, "Flattening null value")
+			} else {
+				doc := vFrom.Interface().(smithyjson.JSONStringer)
+				b, err := doc.MarshalSmithyDocument()
+				if err != nil {
+					tflog.SubsystemError(ctx, subsystemName, "Marshalling JSON document", map[string]any{
+						logAttrKeyError: err.Error(),
+					})
+					diags.Append(diagFlatteningMarshalSmithyDocument(reflect.TypeOf(doc), err))
+					return diags
+				}
+
+				v, d := smithyDocumentValueToFramework(ctx, b)
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				dynamicValue = types.DynamicValue(v)
+			}
+			v, d := tTo.ValueFromDynamic(ctx, dynamicValue)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(v))
+			return diags
+		}
+
 	case fwtypes.NestedObjectType:
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1215,7 +1257,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1235,6 +1277,28 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 		return diags
 	}
 
+	if _, ok := to.(Flattener); !ok {
+		if _, ok := to.(TaggedUnion); ok {
+			//
+			// Walk the interface's underlying tagged union member.
+			//
+			diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Interface(), targetPath, to, flattener)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			// Set the target structure as a mapped Object.
+			val, d := tTo.ValueFromObjectPtr(ctx, to)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+	}
+
 	toFlattener, ok := to.(Flattener)
 	if !ok {
 		val, d := tTo.NullValue(ctx)
@@ -1584,6 +1648,69 @@ func flattenFlattener(ctx context.Context, fromVal reflect.Value, toFlattener Fl
 	return diags
 }
 
+// flattenTaggedUnion copies the `Value` field of a tagged union member to the corresponding field of a tagged union model.
+// All other fields are null.
+func flattenTaggedUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, toTaggedUnion TaggedUnion, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom, typeTo := valFrom.Type(), valTo.Type()
+
+	// Members other than the one being flattened are null.
+	for i := 0; i < typeTo.NumField(); i++ {
+		fieldVal := valTo.Field(i)
+		if !typeTo.Field(i).IsExported() || !fieldVal.CanSet() {
+			continue
+		}
+
+		fieldTo, ok := fieldVal.Interface().(attr.Value)
+		if !ok {
+			continue // Skip non-attr.Type fields.
+		}
+
+		tTo := fieldTo.Type(ctx)
+		v, err := tTo.ValueFromTerraform(ctx, tftypes.NewValue(tTo.TerraformType(ctx), nil))
+		if err != nil || !reflect.TypeOf(v).AssignableTo(fieldVal.Type()) {
+			continue
+		}
+
+		fieldVal.Set(reflect.ValueOf(v))
+	}
+
+	if typeFrom.Name() == "UnknownUnionMember" {
+		tag := valFrom.FieldByName("Tag")
+		if tag.IsValid() && tag.Kind() == reflect.String {
+			tflog.SubsystemError(ctx, subsystemName, "Source is an unknown tagged union member")
+			diags.Append(diagFlatteningUnknownUnionMember(tag.String(), typeTo))
+			return diags
+		}
+	}
+
+	if !slices.ContainsFunc(toTaggedUnion.UnionMembers(), func(v any) bool {
+		return reflect.TypeOf(v) == reflect.PointerTo(typeFrom)
+	}) {
+		tflog.SubsystemError(ctx, subsystemName, "Source is not a tagged union member")
+		diags.Append(diagFlatteningNotUnionMember(typeFrom, typeTo))
+		return diags
+	}
+
+	toField, ok := unionMemberField(typeFrom, typeTo, flexer.getOptions())
+	fromFieldVal := valFrom.FieldByName("Value")
+	if !ok || !fromFieldVal.IsValid() {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding tagged union field")
+		diags.Append(diagFlatteningNotUnionMember(typeFrom, typeTo))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched tagged union member", map[string]any{
+		logAttrKeySourceFieldname: "Value",
+		logAttrKeyTargetFieldname: toField.Name,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName("Value"), fromFieldVal, targetPath.AtName(toField.Name), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
 func flattenPrePopulate(ctx context.Context, toVal reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -1680,3 +1807,23 @@ func diagFlatteningIncompatibleTypes(sourceType, targetType reflect.Type) diag.E
 			fmt.Sprintf("Source type %q cannot be flattened to target type %q.", fullTypeName(sourceType), fullTypeName(targetType)),
 	)
 }
+
+func diagFlatteningNotUnionMember(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q is not a member of tagged union %q.", fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
+func diagFlatteningUnknownUnionMember(tag string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Unknown member %q of tagged union %q.", tag, fullTypeName(targetType)),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -4802,6 +4803,207 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenTaggedUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nil interface Source and list Target": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"single interface Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberString{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:  fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String:  types.StringValue("value1"),
+						Strings: fwtypes.NewListValueOfNull[types.String](ctx),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsFlexTaggedUnion("Field1", reflect.TypeFor[awsUnionMemberString](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceFlatteningTaggedUnionMember("Field1", reflect.TypeFor[awsUnionMemberString](), "Field1", "String", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.String", reflect.TypeFor[types.String]()),
+			},
+		},
+		"single interface Source with nested member and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberNested{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("value1"),
+						}),
+						String:  types.StringNull(),
+						Strings: fwtypes.NewListValueOfNull[types.String](ctx),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsFlexTaggedUnion("Field1", reflect.TypeFor[awsUnionMemberNested](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceFlatteningTaggedUnionMember("Field1", reflect.TypeFor[awsUnionMemberNested](), "Field1", "Nested", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.Nested", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.Nested.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"slice of interface Source and list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberString{
+						Value: "value1",
+					},
+					&awsUnionMemberStrings{
+						Value: []string{"value2"},
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:  fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String:  types.StringValue("value1"),
+						Strings: fwtypes.NewListValueOfNull[types.String](ctx),
+					},
+					{
+						Nested:  fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String:  types.StringNull(),
+						Strings: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{types.StringValue("value2")}),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnion](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsFlexTaggedUnion("Field1[0]", reflect.TypeFor[awsUnionMemberString](), "Field1[0]", reflect.TypeFor[*tfUnion]()),
+				traceFlatteningTaggedUnionMember("Field1[0]", reflect.TypeFor[awsUnionMemberString](), "Field1[0]", "String", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[0].Value", reflect.TypeFor[string](), "Field1[0].String", reflect.TypeFor[types.String]()),
+				infoTargetImplementsFlexTaggedUnion("Field1[1]", reflect.TypeFor[awsUnionMemberStrings](), "Field1[1]", reflect.TypeFor[*tfUnion]()),
+				traceFlatteningTaggedUnionMember("Field1[1]", reflect.TypeFor[awsUnionMemberStrings](), "Field1[1]", "Strings", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[1].Value", reflect.TypeFor[[]string](), "Field1[1].Strings", reflect.TypeFor[fwtypes.ListValueOf[types.String]]()),
+				traceFlatteningWithListValue("Field1[1].Value", reflect.TypeFor[[]string](), 1, "Field1[1].Strings", reflect.TypeFor[fwtypes.ListValueOf[types.String]]()),
+			},
+		},
+		"unknown member Source": {
+			Source: awsUnionSingle{
+				Field1: &UnknownUnionMember{
+					Tag: "new",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			expectedDiags: diag.Diagnostics{
+				diagFlatteningUnknownUnionMember("new", reflect.TypeFor[tfUnion]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsFlexTaggedUnion("Field1", reflect.TypeFor[UnknownUnionMember](), "Field1", reflect.TypeFor[*tfUnion]()),
+				errorFlatteningUnknownTaggedUnionMember("Field1", reflect.TypeFor[UnknownUnionMember](), "Field1", reflect.TypeFor[*tfUnion]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"nil document Source and dynamic Target": {
+			Source: awsDocumentSingle{
+				Field1: nil,
+			},
+			Target: &tfDynamicField{},
+			WantTarget: &tfDynamicField{
+				Field1: types.DynamicNull(),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsDocumentSingle](), reflect.TypeFor[*tfDynamicField]()),
+				infoConverting(reflect.TypeFor[awsDocumentSingle](), reflect.TypeFor[*tfDynamicField]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsDocumentSingle](), "Field1", reflect.TypeFor[*tfDynamicField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[types.Dynamic]()),
+				infoSourceImplementsJSONStringer("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[types.Dynamic]()),
+				traceFlatteningNullValue("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[types.Dynamic]()),
+			},
+		},
+		"document Source and dynamic Target": {
+			Source: awsDocumentSingle{
+				Field1: newTestJSONDocument(map[string]any{
+					"field1": "a",
+					"field2": 42,
+					"field3": []any{true, nil},
+				}),
+			},
+			Target: &tfDynamicField{},
+			WantTarget: &tfDynamicField{
+				Field1: types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{
+						"field1": types.StringType,
+						"field2": types.NumberType,
+						"field3": types.TupleType{ElemTypes: []attr.Type{types.BoolType, types.DynamicType}},
+					},
+					map[string]attr.Value{
+						"field1": types.StringValue("a"),
+						"field2": types.NumberValue(big.NewFloat(42)),
+						"field3": types.TupleValueMust([]attr.Type{types.BoolType, types.DynamicType}, []attr.Value{types.BoolValue(true), types.DynamicNull()}),
+					},
+				)),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsDocumentSingle](), reflect.TypeFor[*tfDynamicField]()),
+				infoConverting(reflect.TypeFor[awsDocumentSingle](), reflect.TypeFor[*tfDynamicField]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsDocumentSingle](), "Field1", reflect.TypeFor[*tfDynamicField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[types.Dynamic]()),
+				infoSourceImplementsJSONStringer("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[types.Dynamic]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
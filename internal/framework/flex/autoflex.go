@@ -34,6 +34,14 @@ type autoFlexer interface {
 	getOptions() AutoFlexOptions
 }
 
+// TaggedUnion is implemented by types that model an AWS API tagged union, i.e. an interface such as
+// `RuleAction` implemented by `RuleActionMember<Name>` structs, each with a single `Value` field.
+// The model has one field `<Name>` per member, of which at most one is set.
+// UnionMembers returns a pointer to the zero value of each member type, e.g. `&awstypes.RuleActionMemberForward{}`.
+type TaggedUnion interface {
+	UnionMembers() []any
+}
+
 // autoFlexValues returns the underlying `reflect.Value`s of `from` and `to`.
 func autoFlexValues(ctx context.Context, from, to any) (context.Context, reflect.Value, reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -100,6 +108,13 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Expanding
+	if fromTaggedUnion, ok := valFrom.Interface().(TaggedUnion); ok && valTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.TaggedUnion")
+		diags.Append(expandTaggedUnion(ctx, sourcePath, fromTaggedUnion, valFrom, targetPath, valTo, flexer)...)
+		return diags
+	}
+
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
@@ -116,6 +131,13 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Flattening
+	if toTaggedUnion, ok := to.(TaggedUnion); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.TaggedUnion")
+		diags.Append(flattenTaggedUnion(ctx, sourcePath, valFrom, targetPath, toTaggedUnion, valTo, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -275,6 +297,38 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 	return reflect.StructField{}, false
 }
 
+// unionMemberField returns the field of the tagged union model `typeModel` corresponding to
+// the union member type `typeMember`, i.e. the field `<Name>` for the type `<Union>Member<Name>`.
+// If more than one field matches, the longest field name wins.
+func unionMemberField(typeMember, typeModel reflect.Type, opts AutoFlexOptions) (reflect.StructField, bool) {
+	var result reflect.StructField
+	var found bool
+
+	memberName := typeMember.Name()
+	for i := 0; i < typeModel.NumField(); i++ {
+		field := typeModel.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if opts.isIgnoredField(field.Name) {
+			continue
+		}
+		if nameOverride, _ := autoflexTags(field); nameOverride == "-" {
+			continue
+		}
+
+		suffix := "Member" + field.Name
+		if n := len(memberName) - len(suffix); n < 0 || !strings.EqualFold(memberName[n:], suffix) {
+			continue
+		}
+		if !found || len(field.Name) > len(result.Name) {
+			result, found = field, true
+		}
+	}
+
+	return result, found
+}
+
 func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	_, ok := structType.FieldByName(field)
 	return ok
@@ -702,3 +702,68 @@ type tfSetOfStringEnum struct {
 type awsSliceOfStringEnum struct {
 	Field1 []testEnum
 }
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberString struct {
+	Value string
+}
+
+type awsUnionMemberStrings struct {
+	Value []string
+}
+
+type awsUnionMemberNested struct {
+	Value awsSingleStringValue
+}
+
+type UnknownUnionMember struct {
+	Tag   string
+	Value []byte
+}
+
+var (
+	_ awsUnion = &awsUnionMemberString{}
+	_ awsUnion = &awsUnionMemberStrings{}
+	_ awsUnion = &awsUnionMemberNested{}
+	_ awsUnion = &UnknownUnionMember{}
+)
+
+func (*awsUnionMemberString) isAWSUnion()  {} // nosemgrep:ci.aws-in-func-name
+func (*awsUnionMemberStrings) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+func (*awsUnionMemberNested) isAWSUnion()  {} // nosemgrep:ci.aws-in-func-name
+func (*UnknownUnionMember) isAWSUnion()    {} // nosemgrep:ci.aws-in-func-name
+
+type tfUnion struct {
+	Nested  fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"nested"`
+	String  types.String                                         `tfsdk:"string"`
+	Strings fwtypes.ListValueOf[types.String]                    `tfsdk:"strings"`
+}
+
+var _ TaggedUnion = tfUnion{}
+
+func (tfUnion) UnionMembers() []any {
+	return []any{
+		&awsUnionMemberNested{},
+		&awsUnionMemberString{},
+		&awsUnionMemberStrings{},
+	}
+}
+
+type awsDocumentSingle struct {
+	Field1 smithyjson.JSONStringer
+}
+
+type tfDynamicField struct {
+	Field1 types.Dynamic `tfsdk:"field1"`
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	smithyjson "github.com/isometry/terraform-provider-faws/internal/json"
+)
+
+// smithyDocumentValueFromFramework converts a Plugin Framework value, typically the underlying value of a types.Dynamic,
+// to a Go value that can be used to construct a Smithy document.
+func smithyDocumentValueFromFramework(ctx context.Context, v attr.Value) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+
+	switch v := v.(type) {
+	case basetypes.DynamicValuable:
+		v2, d := v.ToDynamicValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return smithyDocumentValueFromFramework(ctx, v2.UnderlyingValue())
+
+	case basetypes.BoolValuable:
+		v2, d := v.ToBoolValue(ctx)
+		diags.Append(d...)
+		return v2.ValueBool(), diags
+
+	case basetypes.StringValuable:
+		v2, d := v.ToStringValue(ctx)
+		diags.Append(d...)
+		return v2.ValueString(), diags
+
+	case basetypes.Int64Valuable:
+		v2, d := v.ToInt64Value(ctx)
+		diags.Append(d...)
+		return v2.ValueInt64(), diags
+
+	case basetypes.Float64Valuable:
+		v2, d := v.ToFloat64Value(ctx)
+		diags.Append(d...)
+		return v2.ValueFloat64(), diags
+
+	case basetypes.NumberValuable:
+		v2, d := v.ToNumberValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		f := v2.ValueBigFloat()
+		if f.IsInt() {
+			if i, accuracy := f.Int64(); accuracy == big.Exact {
+				return i, diags
+			}
+		}
+		f64, _ := f.Float64()
+		return f64, diags
+
+	case basetypes.ListValuable:
+		v2, d := v.ToListValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return smithyDocumentSliceFromFramework(ctx, v2.Elements())
+
+	case basetypes.SetValuable:
+		v2, d := v.ToSetValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return smithyDocumentSliceFromFramework(ctx, v2.Elements())
+
+	case basetypes.TupleValue:
+		return smithyDocumentSliceFromFramework(ctx, v.Elements())
+
+	case basetypes.MapValuable:
+		v2, d := v.ToMapValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return smithyDocumentMapFromFramework(ctx, v2.Elements())
+
+	case basetypes.ObjectValuable:
+		v2, d := v.ToObjectValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return smithyDocumentMapFromFramework(ctx, v2.Attributes())
+	}
+
+	diags.Append(diagExpandingIncompatibleTypes(reflect.TypeOf(v), reflect.TypeFor[smithyjson.JSONStringer]()))
+	return nil, diags
+}
+
+func smithyDocumentSliceFromFramework(ctx context.Context, elements []attr.Value) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s := make([]any, len(elements))
+	for i, element := range elements {
+		v, d := smithyDocumentValueFromFramework(ctx, element)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		s[i] = v
+	}
+
+	return s, diags
+}
+
+func smithyDocumentMapFromFramework(ctx context.Context, elements map[string]attr.Value) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	m := make(map[string]any, len(elements))
+	for k, element := range elements {
+		v, d := smithyDocumentValueFromFramework(ctx, element)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		m[k] = v
+	}
+
+	return m, diags
+}
+
+// smithyDocumentValueToFramework converts the JSON encoding of a Smithy document to a Plugin Framework value
+// suitable for use as the underlying value of a types.Dynamic.
+// JSON objects are converted to Objects and JSON arrays to Tuples, as the types of their elements may differ.
+func smithyDocumentValueToFramework(ctx context.Context, b []byte) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		diags.AddError("Decoding JSON document", err.Error())
+		return nil, diags
+	}
+
+	return smithyDocumentGoValueToFramework(ctx, v)
+}
+
+func smithyDocumentGoValueToFramework(ctx context.Context, v any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch v := v.(type) {
+	case nil:
+		return types.DynamicNull(), diags
+
+	case bool:
+		return types.BoolValue(v), diags
+
+	case string:
+		return types.StringValue(v), diags
+
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			diags.AddError("Decoding JSON document", err.Error())
+			return nil, diags
+		}
+
+		return types.NumberValue(f), diags
+
+	case []any:
+		elementTypes := make([]attr.Type, len(v))
+		elements := make([]attr.Value, len(v))
+		for i, v := range v {
+			element, d := smithyDocumentGoValueToFramework(ctx, v)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			elementTypes[i] = element.Type(ctx)
+			elements[i] = element
+		}
+
+		tuple, d := types.TupleValue(elementTypes, elements)
+		diags.Append(d...)
+		return tuple, diags
+
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+		for k, v := range v {
+			attribute, d := smithyDocumentGoValueToFramework(ctx, v)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			attributeTypes[k] = attribute.Type(ctx)
+			attributes[k] = attribute
+		}
+
+		object, d := types.ObjectValue(attributeTypes, attributes)
+		diags.Append(d...)
+		return object, diags
+	}
+
+	diags.AddError("Decoding JSON document", fmt.Sprintf("unexpected type %T", v))
+	return nil, diags
+}
+
+// setSmithyDocument sets the Smithy document target value.
+func setSmithyDocument(doc smithyjson.JSONStringer, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if doc == nil {
+		return diags
+	}
+
+	val := reflect.ValueOf(doc)
+	if !val.Type().AssignableTo(vTo.Type()) {
+		diags.Append(diagCannotBeAssigned(val.Type(), vTo.Type()))
+		return diags
+	}
+
+	vTo.Set(val)
+
+	return diags
+}
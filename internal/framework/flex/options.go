@@ -3,6 +3,12 @@
 
 package flex
 
+import (
+	"reflect"
+
+	smithyjson "github.com/isometry/terraform-provider-faws/internal/json"
+)
+
 var (
 	DefaultIgnoredFieldNames = []string{
 		"Tags", // Resource tags are handled separately.
@@ -25,6 +31,10 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// smithyDocumentConstructors stores the constructor of each Smithy document
+	// type, used when expanding JSON strings and dynamic values into documents
+	smithyDocumentConstructors map[reflect.Type]func(any) smithyjson.JSONStringer
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -75,6 +85,28 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithSmithyDocumentConstructor specifies the constructor of the Smithy document
+// type T, typically a service's `document.NewLazyDocument`
+//
+// Use this option to expand JSON string or dynamic values into AWS API
+// document fields. The document type cannot be constructed otherwise.
+func WithSmithyDocumentConstructor[T smithyjson.JSONStringer](f func(any) T) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.smithyDocumentConstructors == nil {
+			o.smithyDocumentConstructors = make(map[reflect.Type]func(any) smithyjson.JSONStringer)
+		}
+		o.smithyDocumentConstructors[reflect.TypeFor[T]()] = func(v any) smithyjson.JSONStringer {
+			return f(v)
+		}
+	}
+}
+
+// smithyDocumentConstructor returns the constructor of the Smithy document type t, if any
+func (o *AutoFlexOptions) smithyDocumentConstructor(t reflect.Type) (func(any) smithyjson.JSONStringer, bool) {
+	f, ok := o.smithyDocumentConstructors[t]
+	return f, ok
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	for _, name := range o.ignoredFieldNames {
@@ -100,6 +100,16 @@ var (
 	_ xattr.ValidateableAttribute                = (*SmithyJSON[smithyjson.JSONStringer])(nil)
 )
 
+// SmithyDocumentValuable is implemented by values that can be converted to a Smithy document
+// without knowing the document's concrete interface type.
+type SmithyDocumentValuable interface {
+	ValueSmithyDocument() (smithyjson.JSONStringer, diag.Diagnostics)
+}
+
+var (
+	_ SmithyDocumentValuable = (*SmithyJSON[smithyjson.JSONStringer])(nil)
+)
+
 type SmithyJSON[T smithyjson.JSONStringer] struct {
 	basetypes.StringValue
 	f func(any) T
@@ -139,6 +149,15 @@ func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
 	return v.f(data), diags
 }
 
+func (v SmithyJSON[T]) ValueSmithyDocument() (smithyjson.JSONStringer, diag.Diagnostics) {
+	doc, diags := v.ValueInterface()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return doc, diags
+}
+
 func (v SmithyJSON[T]) Type(context.Context) attr.Type {
 	return SmithyJSONType[T]{}
 }
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/isometry/terraform-provider-faws/internal/generate/common"
+	"github.com/isometry/terraform-provider-faws/names/data"
+)
+
+func main() {
+	filename := `data/service_availability.json`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
+	g := common.NewGenerator()
+
+	g.Infof("Generating names/%s", filename)
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	partitions := endpoints.DefaultResolver().(endpoints.EnumPartitions).Partitions()
+
+	// Service package -> partition ID -> Region IDs.
+	availability := make(map[string]map[string][]string)
+
+	for _, l := range serviceData {
+		if l.Exclude() || l.NotImplemented() {
+			continue
+		}
+
+		// The provider calls services with Region overrides in a fixed Region, wherever they are configured.
+		if len(l.EndpointRegionOverrides()) > 0 {
+			continue
+		}
+
+		p := l.ProviderPackage()
+		endpointsID, ok := serviceEndpointsID(partitions, l)
+		if !ok {
+			g.Infof("No endpoint metadata for service package %s", p)
+			continue
+		}
+
+		for _, partition := range partitions {
+			service, ok := partition.Services()[endpointsID]
+			if !ok {
+				continue
+			}
+
+			// Global services (and services with no metadata in the partition) have no regional endpoints.
+			// Their availability is unknown and is not checked.
+			regions := slices.Sorted(maps.Keys(service.Regions()))
+			if len(regions) == 0 {
+				continue
+			}
+
+			if _, ok := availability[p]; !ok {
+				availability[p] = make(map[string][]string)
+			}
+			availability[p][partition.ID()] = regions
+		}
+	}
+
+	body, err := json.MarshalIndent(availability, "", "  ")
+
+	if err != nil {
+		g.Fatalf("error encoding service availability: %s", err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes(append(body, '\n')); err != nil {
+		g.Fatalf("error generating service availability: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// serviceEndpointsID returns the ID of the service in the AWS SDK for Go v1 endpoint metadata.
+// The endpoint ID (the service's endpoint prefix) usually matches the AWS CLI v2 command or the service package.
+func serviceEndpointsID(partitions []endpoints.Partition, l data.ServiceRecord) (string, bool) {
+	for _, id := range []string{l.AWSCLIV2Command(), l.ProviderPackage(), l.GoV1Package()} {
+		for _, partition := range partitions {
+			if _, ok := partition.Services()[id]; ok {
+				return id, true
+			}
+		}
+	}
+
+	return "", false
+}
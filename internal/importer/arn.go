@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// arnWildcardSuffix is the suffix included in the ARNs of some resources, e.g. CloudWatch Logs log groups,
+// when returned by AWS Config or AWS Resource Explorer but not stored in the resource's `arn` attribute.
+const arnWildcardSuffix = ":*"
+
+// ParseARN parses the specified import ID as an ARN.
+// It returns false if the import ID isn't an ARN.
+func ParseARN(id string) (arn.ARN, bool) {
+	if !arn.IsARN(id) {
+		return arn.ARN{}, false
+	}
+
+	v, err := arn.Parse(strings.TrimSuffix(id, arnWildcardSuffix))
+	if err != nil {
+		return arn.ARN{}, false
+	}
+
+	return v, true
+}
+
+// CheckARN returns an error if the specified ARN isn't in the specified partition, AWS account or Region.
+// The AWS account and Region are only checked when present in both the ARN and the provider configuration.
+func CheckARN(v arn.ARN, partition, accountID, region string) error {
+	if v.Partition != partition {
+		return fmt.Errorf("ARN (%s) partition (%s) doesn't match the provider's partition (%s)", v, v.Partition, partition)
+	}
+
+	if v.AccountID != "" && accountID != "" && v.AccountID != accountID {
+		return fmt.Errorf("ARN (%s) AWS account (%s) doesn't match the provider's AWS account (%s)", v, v.AccountID, accountID)
+	}
+
+	if v.Region != "" && region != "" && v.Region != region {
+		return fmt.Errorf("ARN (%s) Region (%s) doesn't match the provider's Region (%s)", v, v.Region, region)
+	}
+
+	return nil
+}
+
+// ARNImportIDs returns the import IDs to try, in order, when importing a resource by the specified ARN
+// after the ARN itself has been tried. A resource's natural key is usually one of:
+//   - The ARN's resource without its resource type, e.g. /aws/lambda/example from log-group:/aws/lambda/example
+//   - The final element of the ARN's resource, e.g. example from role/path/example
+//   - The ARN's resource, e.g. example from an S3 bucket ARN or alias/example from a KMS alias ARN
+func ARNImportIDs(v arn.ARN) []string {
+	var ids []string
+
+	add := func(id string) {
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	resource := v.Resource
+	if i := strings.IndexAny(resource, ":/"); i >= 0 {
+		add(resource[i+1:])
+	}
+	if i := strings.LastIndexAny(resource, ":/"); i >= 0 {
+		add(resource[i+1:])
+	}
+	add(resource)
+
+	return ids
+}
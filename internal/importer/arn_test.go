@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/isometry/terraform-provider-faws/internal/importer"
+)
+
+func TestParseARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id       string
+		expected string
+		ok       bool
+	}{
+		"name": {
+			id: "example",
+		},
+		"URL": {
+			id: "https://sqs.us-west-2.amazonaws.com/123456789012/example",
+		},
+		"invalid ARN": {
+			id: "arn:aws:sns",
+		},
+		"ARN": {
+			id:       "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			expected: "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			ok:       true,
+		},
+		"ARN with wildcard suffix": {
+			id:       "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/example:*", //lintignore:AWSAT003,AWSAT005
+			expected: "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/example",   //lintignore:AWSAT003,AWSAT005
+			ok:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, ok := importer.ParseARN(testCase.id)
+
+			if got, want := ok, testCase.ok; got != want {
+				t.Fatalf("ok = %t, want %t", got, want)
+			}
+
+			if ok {
+				if got, want := v.String(), testCase.expected; got != want {
+					t.Errorf("ARN = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id          string
+		partition   string
+		accountID   string
+		region      string
+		expectError bool
+	}{
+		"match": {
+			id:        "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			partition: "aws",
+			accountID: "123456789012",
+			region:    "us-west-2",
+		},
+		"global": {
+			id:        "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			partition: "aws",
+			accountID: "123456789012",
+			region:    "us-west-2",
+		},
+		"no account": {
+			id:        "arn:aws:s3:::example", //lintignore:AWSAT005
+			partition: "aws",
+			accountID: "123456789012",
+			region:    "us-west-2",
+		},
+		"provider account unknown": {
+			id:        "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			partition: "aws",
+			region:    "us-west-2",
+		},
+		"partition mismatch": {
+			id:          "arn:aws-us-gov:sns:us-gov-west-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			partition:   "aws",
+			accountID:   "123456789012",
+			region:      "us-west-2",
+			expectError: true,
+		},
+		"account mismatch": {
+			id:          "arn:aws:sns:us-west-2:210987654321:example", //lintignore:AWSAT003,AWSAT005
+			partition:   "aws",
+			accountID:   "123456789012",
+			region:      "us-west-2",
+			expectError: true,
+		},
+		"region mismatch": {
+			id:          "arn:aws:sns:us-east-1:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			partition:   "aws",
+			accountID:   "123456789012",
+			region:      "us-west-2",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, ok := importer.ParseARN(testCase.id)
+			if !ok {
+				t.Fatalf("parsing ARN (%s) failed", testCase.id)
+			}
+
+			err := importer.CheckARN(v, testCase.partition, testCase.accountID, testCase.region)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("err = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestARNImportIDs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id       string
+		expected []string
+	}{
+		"SNS topic": {
+			id: "arn:aws:sns:us-west-2:123456789012:example", //lintignore:AWSAT003,AWSAT005
+			expected: []string{
+				"example",
+			},
+		},
+		"IAM role with path": {
+			id: "arn:aws:iam::123456789012:role/path/example", //lintignore:AWSAT005
+			expected: []string{
+				"path/example",
+				"example",
+				"role/path/example",
+			},
+		},
+		"CloudWatch Logs log group": {
+			id: "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/example", //lintignore:AWSAT003,AWSAT005
+			expected: []string{
+				"/aws/lambda/example",
+				"example",
+				"log-group:/aws/lambda/example",
+			},
+		},
+		"S3 bucket": {
+			id: "arn:aws:s3:::example", //lintignore:AWSAT005
+			expected: []string{
+				"example",
+			},
+		},
+		"EC2 instance": {
+			id: "arn:aws:ec2:us-west-2:123456789012:instance/i-0123456789abcdef0", //lintignore:AWSAT003,AWSAT005
+			expected: []string{
+				"i-0123456789abcdef0",
+				"instance/i-0123456789abcdef0",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, ok := importer.ParseARN(testCase.id)
+			if !ok {
+				t.Fatalf("parsing ARN (%s) failed", testCase.id)
+			}
+
+			if diff := cmp.Diff(importer.ARNImportIDs(v), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// GlobMatch reports whether the slash-separated relative path name matches the shell pattern.
+// A "**" path segment matches zero or more path segments.
+// A pattern with no slash is matched against the final path element only.
+func GlobMatch(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := filepath.Match(pattern, filepath.Base(name))
+		return ok
+	}
+
+	return globMatchSegments(strings.Split(strings.TrimPrefix(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+func globMatchSegments(patterns, names []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := len(names); i >= 0; i-- {
+				if globMatchSegments(patterns[1:], names[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(names) == 0 {
+			return false
+		}
+
+		if ok, _ := filepath.Match(patterns[0], names[0]); !ok {
+			return false
+		}
+
+		patterns, names = patterns[1:], names[1:]
+	}
+
+	return len(names) == 0
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"testing"
+)
+
+func TestGlobMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "*.html", name: "index.html", want: true},
+		{pattern: "*.html", name: "docs/guide/index.html", want: true},
+		{pattern: "*.html", name: "index.htm", want: false},
+		{pattern: "assets/*.js", name: "assets/app.js", want: true},
+		{pattern: "assets/*.js", name: "assets/vendor/lib.js", want: false},
+		{pattern: "/assets/*.js", name: "assets/app.js", want: true},
+		{pattern: "assets/**", name: "assets/vendor/lib.js", want: true},
+		{pattern: "assets/**", name: "static/app.js", want: false},
+		{pattern: "**/*.map", name: "app.js.map", want: true},
+		{pattern: "**/*.map", name: "assets/vendor/lib.js.map", want: true},
+		{pattern: "assets/**/*.css", name: "assets/site.css", want: true},
+		{pattern: "assets/**/*.css", name: "assets/a/b/site.css", want: true},
+		{pattern: "**/__pycache__/**", name: "pkg/__pycache__/mod.pyc", want: true},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := GlobMatch(testCase.pattern, testCase.name), testCase.want; got != want {
+			t.Errorf("GlobMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+)
+
+// setDefaultTimeouts overrides Plugin SDK resources' compiled-in default timeouts with the provider's `default_timeouts`.
+// Resources' default timeouts are recorded in the plan, so a resource's `timeouts` block still takes precedence.
+// Only operations for which a resource already has a default timeout are overridden, as these determine the resource's `timeouts` block schema.
+func setDefaultTimeouts(ctx context.Context, provider *schema.Provider, compiledTimeouts map[string]*schema.ResourceTimeout, meta *conns.AWSClient) {
+	for typeName, compiled := range compiledTimeouts {
+		r, ok := provider.ResourcesMap[typeName]
+		if !ok {
+			continue
+		}
+
+		v, ok := meta.DefaultTimeouts(ctx, typeName)
+		if !ok {
+			r.Timeouts = compiled
+			continue
+		}
+
+		timeouts := *compiled
+		timeouts.Create = overrideTimeout(compiled.Create, v.Create)
+		timeouts.Read = overrideTimeout(compiled.Read, v.Read)
+		timeouts.Update = overrideTimeout(compiled.Update, v.Update)
+		timeouts.Delete = overrideTimeout(compiled.Delete, v.Delete)
+		r.Timeouts = &timeouts
+	}
+}
+
+func overrideTimeout(compiled *time.Duration, timeout time.Duration) *time.Duration {
+	if compiled == nil || timeout == 0 {
+		return compiled
+	}
+
+	return &timeout
+}
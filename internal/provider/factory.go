@@ -34,5 +34,9 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	server := newPlanWarningsServer(muxServer.ProviderServer)
+	server = newServiceQuotaPreflightServer(primary, server)
+	server = newServiceAvailabilityPreflightServer(ctx, primary, server)
+
+	return server, primary, nil
 }
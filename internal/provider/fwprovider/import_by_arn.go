@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isometry/terraform-provider-faws/internal/importer"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+// importByARN imports a resource by its ARN in addition to its usual import ID.
+// The ARN is first checked against the provider's partition, AWS account and Region.
+// If the ARN isn't itself the resource's import ID, each of the import IDs derived from it is tried in turn,
+// reading the resource after the import, until one results in a resource with that ARN.
+func (w *wrappedResource) importByARN(ctx context.Context, inner resource.ResourceWithImportState, v arn.ARN, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if err := importer.CheckARN(v, w.meta.Partition(ctx), w.meta.AccountID(ctx), w.meta.Region(ctx)); err != nil {
+		response.Diagnostics.AddError("Importing by ARN", err.Error())
+		return
+	}
+
+	// Some resources' import ID is their ARN.
+	result, diags := w.importAndRead(ctx, inner, request, "", *response)
+	if !diags.HasError() {
+		*response = result
+		return
+	}
+
+	arn := v.String()
+	for _, id := range importer.ARNImportIDs(v) {
+		tflog.Debug(ctx, "Importing by ARN", map[string]any{
+			names.AttrARN: arn,
+			"import_id":   id,
+		})
+
+		request := request
+		request.ID = id
+		if result, d := w.importAndRead(ctx, inner, request, arn, *response); !d.HasError() {
+			*response = result
+			return
+		}
+	}
+
+	response.Diagnostics.AddError("Importing by ARN", fmt.Sprintf("no resource found with ARN (%s), try importing using the import ID in the resource's documentation", arn))
+	response.Diagnostics.Append(diags...)
+}
+
+// importAndRead imports and then reads the resource with the specified import ID.
+// It returns error diagnostics if the import or read fails, the resource isn't found or, if specified, the resource's ARN doesn't match.
+func (w *wrappedResource) importAndRead(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, arn string, response resource.ImportStateResponse) (resource.ImportStateResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	inner.ImportState(ctx, request, &response)
+	if response.Diagnostics.HasError() || response.Deferred != nil {
+		return response, response.Diagnostics
+	}
+
+	readRequest := resource.ReadRequest{
+		State:              response.State,
+		Private:            response.Private,
+		ClientCapabilities: resource.ReadClientCapabilities{DeferralAllowed: request.ClientCapabilities.DeferralAllowed},
+	}
+	readResponse := resource.ReadResponse{
+		State:   response.State,
+		Private: response.Private,
+	}
+	w.Read(ctx, readRequest, &readResponse)
+	diags.Append(readResponse.Diagnostics...)
+	if diags.HasError() {
+		return response, diags
+	}
+
+	if readResponse.State.Raw.IsNull() {
+		diags.AddError("Importing by ARN", fmt.Sprintf("no resource found with import ID (%s)", request.ID))
+		return response, diags
+	}
+
+	if arn != "" {
+		if v := stateARN(readResponse.State); v != arn {
+			diags.AddError("Importing by ARN", fmt.Sprintf("resource ARN (%s) doesn't match", v))
+			return response, diags
+		}
+	}
+
+	response.State = readResponse.State
+	response.Private = readResponse.Private
+
+	return response, diags
+}
+
+// hasComputedARNAttribute returns whether the specified state's schema has a Computed string `arn` attribute.
+func hasComputedARNAttribute(ctx context.Context, state tfsdk.State) bool {
+	if state.Schema == nil {
+		return false
+	}
+
+	v, ok := state.Schema.GetAttributes()[names.AttrARN]
+
+	return ok && v.IsComputed() && v.GetType().TerraformType(ctx).Is(tftypes.String)
+}
+
+// stateARN returns the value of the specified state's `arn` attribute.
+func stateARN(state tfsdk.State) string {
+	v, _, err := tftypes.WalkAttributePath(state.Raw, tftypes.NewAttributePath().WithAttributeName(names.AttrARN))
+	if err != nil {
+		return ""
+	}
+
+	var arn string
+	if v, ok := v.(tftypes.Value); ok && v.IsKnown() && !v.IsNull() {
+		if err := v.As(&arn); err != nil {
+			return ""
+		}
+	}
+
+	return arn
+}
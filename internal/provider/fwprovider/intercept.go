@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/isometry/terraform-provider-faws/internal/conns"
 	"github.com/isometry/terraform-provider-faws/internal/errs"
+	"github.com/isometry/terraform-provider-faws/internal/errs/fwdiag"
 	"github.com/isometry/terraform-provider-faws/internal/framework/flex"
 	"github.com/isometry/terraform-provider-faws/internal/slices"
 	tftags "github.com/isometry/terraform-provider-faws/internal/tags"
@@ -576,3 +577,39 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// decodeAuthorizationMessages decodes any encoded authorization failure messages in error diagnostics,
+// if enabled by the provider's `decode_authorization_messages` argument.
+func decodeAuthorizationMessages(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != OnError || meta == nil {
+		return ctx, diags
+	}
+
+	return ctx, fwdiag.AppendDecodedAuthorizationMessages(ctx, diags, meta.AuthorizationMessageDecoder(ctx))
+}
+
+// decodeAuthorizationMessageDataSourceInterceptor decodes encoded authorization failure messages for data sources.
+type decodeAuthorizationMessageDataSourceInterceptor struct{}
+
+func (r decodeAuthorizationMessageDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+// decodeAuthorizationMessageResourceInterceptor decodes encoded authorization failure messages for resources.
+type decodeAuthorizationMessageResourceInterceptor struct{}
+
+func (r decodeAuthorizationMessageResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+func (r decodeAuthorizationMessageResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+func (r decodeAuthorizationMessageResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+func (r decodeAuthorizationMessageResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return decodeAuthorizationMessages(ctx, meta, when, diags)
+}
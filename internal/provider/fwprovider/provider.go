@@ -7,8 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -64,6 +66,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"decode_authorization_messages": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to decode encoded authorization failure messages in error messages using STS DecodeAuthorizationMessage. Requires the `sts:DecodeAuthorizationMessage` permission.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -84,6 +90,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_policy_recording_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which an IAM policy document allowing the AWS API actions invoked by the provider is written. Can also be set using the `" + conns.IAMPolicyRecordingPathEnvVar + "` environment variable.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -253,13 +263,57 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
-			"default_tags": schema.ListNestedBlock{
+			"custom_partition": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
 				},
-				Description: "Configuration block with settings to default resource tags across all resources.",
+				Description: "Configuration block defining a partition not in the AWS SDK's endpoint metadata, e.g. an AWS-compatible environment or an isolated Region.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"dns_suffix": schema.StringAttribute{
+							Required:    true,
+							Description: "The domain suffix of service endpoints in the partition, e.g. `example.com`.",
+						},
+						"dual_stack_dns_suffix": schema.StringAttribute{
+							Optional:    true,
+							Description: "The domain suffix of dual-stack service endpoints in the partition. Defaults to `dns_suffix`.",
+						},
+						"fips_dns_suffix": schema.StringAttribute{
+							Optional:    true,
+							Description: "The domain suffix of FIPS service endpoints in the partition. Defaults to `dns_suffix`.",
+						},
+						"hosted_zone_ids": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Map of service to the Route 53 hosted zone ID of its endpoints in the partition. " +
+								"Valid keys are `" + strings.Join(conns.HostedZoneIDKeys(), "`, `") + "`.",
+						},
+						names.AttrID: schema.StringAttribute{
+							Required:    true,
+							Description: "The partition ID, used in ARNs, e.g. `aws-example`.",
+						},
+						"region_regex": schema.StringAttribute{
+							Required:    true,
+							Description: "Regular expression matching the IDs of Regions in the partition, e.g. `^example-\\w+-\\d+$`.",
+						},
+					},
+				},
+			},
+			"default_tags": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to default resource tags across all resources, " +
+					"or across resources of the included and not excluded types.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, or patterns with `*` wildcards, to which the tags are not defaulted.",
+						},
+						"include_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, or patterns with `*` wildcards, to which the tags are defaulted. Defaults to all resource types.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -270,6 +324,33 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				},
 			},
 			"endpoints": endpointsBlock(),
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with default operation timeouts for resource types, overriding resources' compiled-in defaults.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Create timeout, e.g. `60m`.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Delete timeout, e.g. `60m`.",
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Read timeout, e.g. `60m`.",
+						},
+						names.AttrResourceType: schema.StringAttribute{
+							Required:    true,
+							Description: "The resource type, e.g. `aws_db_instance`, or a pattern with `*` wildcards, e.g. `aws_rds_*`.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Update timeout, e.g. `60m`.",
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -283,12 +364,97 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources, e.g. `^kubernetes\\.io/cluster/`.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tag keys to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
+						"value_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
+					},
+				},
+			},
+			"service_availability_preflight": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to check that the services of planned resource creations are available in the configured Region.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAction: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(conns.ServiceAvailabilityPreflightActionError, conns.ServiceAvailabilityPreflightActionWarn),
+							},
+							Description: "Whether a plan that creates a resource of a service that isn't available in the Region fails (`ERROR`) or is reported as a warning (`WARN`). " +
+								"Defaults to `WARN`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"override": schema.ListNestedBlock{
+							Description: "Regions in which a service is available in addition to those in the provider's service availability data, e.g. for private previews.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"regions": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "The Regions in which the service is available. If not set, the service is treated as available in all Regions.",
+									},
+									"service": schema.StringAttribute{
+										Required:    true,
+										Description: "The service package, e.g. `bedrock`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_quota_preflight": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to check planned resource creations against service quotas.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAction: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(conns.ServiceQuotaPreflightActionError, conns.ServiceQuotaPreflightActionWarn),
+							},
+							Description: "Whether a plan that would exceed a service quota fails (`ERROR`) or is reported as a warning (`WARN`). " +
+								"Defaults to `WARN`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"quota": schema.ListNestedBlock{
+							Description: "Additional mapping of a resource type to a service quota consumed by each resource of that type.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"quota_code": schema.StringAttribute{
+										Required:    true,
+										Description: "The service quota code, e.g. `L-F678F1CE`.",
+									},
+									names.AttrResourceType: schema.StringAttribute{
+										Required:    true,
+										Description: "The resource type, e.g. `aws_vpc`.",
+									},
+									"service_code": schema.StringAttribute{
+										Required:    true,
+										Description: "The service code, e.g. `vpc`.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -351,7 +517,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 				return ctx
 			}
-			interceptors := dataSourceInterceptors{}
+			interceptors := dataSourceInterceptors{decodeAuthorizationMessageDataSourceInterceptor{}}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
@@ -418,16 +584,19 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
 					ctx = flex.RegisterLogger(ctx)
+					if v, ok := meta.DefaultTimeouts(ctx, typeName); ok {
+						ctx = conns.NewDefaultTimeoutsContext(ctx, v)
+					}
 				}
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{decodeAuthorizationMessageResourceInterceptor{}}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
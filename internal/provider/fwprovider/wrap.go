@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/importer"
 )
 
 // contextFunc augments Context.
@@ -218,6 +219,14 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		// Resources exporting an ARN can also be imported by ARN.
+		if arn, ok := importer.ParseARN(request.ID); ok && w.meta != nil && hasComputedARNAttribute(ctx, response.State) {
+			w.importByARN(ctx, v, arn, request, response)
+
+			return
+		}
+
 		v.ImportState(ctx, request, response)
 
 		return
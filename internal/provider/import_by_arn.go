@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
+	"github.com/isometry/terraform-provider-faws/internal/importer"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+// importByARN returns a StateContextFunc that accepts a resource's ARN as its import ID in addition to its usual import ID.
+// The ARN is first checked against the provider's partition, AWS account and Region.
+// If the ARN isn't itself the resource's import ID, each of the import IDs derived from it is tried in turn,
+// reading the resource after the import, until one results in a resource with that ARN.
+func importByARN(typeName string, r *schema.Resource, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return f(ctx, d, meta)
+		}
+
+		v, ok := importer.ParseARN(d.Id())
+		if !ok {
+			return f(ctx, d, meta)
+		}
+
+		if err := importer.CheckARN(v, c.Partition(ctx), c.AccountID(ctx), c.Region(ctx)); err != nil {
+			return nil, fmt.Errorf("importing %s by ARN: %w", typeName, err)
+		}
+
+		// Some resources' import ID is their ARN.
+		results, importErr := importAndRead(ctx, typeName, r, f, d.Id(), "", meta)
+		if importErr == nil {
+			return results, nil
+		}
+
+		arn := v.String()
+		for _, id := range importer.ARNImportIDs(v) {
+			tflog.Debug(ctx, "Importing by ARN", map[string]any{
+				names.AttrARN: arn,
+				"import_id":   id,
+			})
+
+			if results, err := importAndRead(ctx, typeName, r, f, id, arn, meta); err == nil {
+				return results, nil
+			}
+		}
+
+		return nil, fmt.Errorf("importing %s by ARN: no resource found with ARN (%s), try importing using the import ID in the resource's documentation: %w", typeName, arn, importErr)
+	}
+}
+
+var errImportByARNNotFound = errors.New("resource not found")
+
+// importAndRead imports and then reads the resource with the specified import ID.
+// It returns an error if the import or read fails, the resource isn't found or, if specified, the resource's ARN doesn't match.
+func importAndRead(ctx context.Context, typeName string, r *schema.Resource, f schema.StateContextFunc, id, arn string, meta any) ([]*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(id)
+	d.SetType(typeName)
+
+	results, err := f(ctx, d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) != 1 {
+		return nil, fmt.Errorf("unexpected import result count: %d", len(results))
+	}
+
+	d = results[0]
+	if diags := r.ReadWithoutTimeout(ctx, d, meta); diags.HasError() {
+		return nil, sdkdiag.DiagnosticsError(diags)
+	}
+
+	if d.Id() == "" {
+		return nil, errImportByARNNotFound
+	}
+
+	if v := d.Get(names.AttrARN).(string); arn != "" && v != arn {
+		return nil, fmt.Errorf("resource ARN (%s) doesn't match", v)
+	}
+
+	return results, nil
+}
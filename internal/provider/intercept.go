@@ -195,6 +195,20 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
+// decodeAuthorizationMessageInterceptor decodes any encoded authorization failure messages in error diagnostics,
+// if enabled by the provider's `decode_authorization_messages` argument.
+func decodeAuthorizationMessageInterceptor(ctx context.Context, _ schemaResourceData, meta any, when when, _ why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != OnError {
+		return ctx, diags
+	}
+
+	if v, ok := meta.(*conns.AWSClient); ok {
+		diags = sdkdiag.AppendDecodedAuthorizationMessages(ctx, diags, v.AuthorizationMessageDecoder(ctx))
+	}
+
+	return ctx, diags
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsResourceInterceptor implements transparent tagging for resources.
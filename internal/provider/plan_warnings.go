@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+)
+
+// planWarningsServer wraps the muxed provider server,
+// adding the warnings raised by resources while planning, e.g. from a Plugin SDK CustomizeDiff, to the plan's diagnostics.
+type planWarningsServer struct {
+	tfprotov5.ProviderServer
+}
+
+func newPlanWarningsServer(server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &planWarningsServer{
+			ProviderServer: server(),
+		}
+	}
+}
+
+func (s *planWarningsServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, warnings := conns.NewPlanWarningsContext(ctx)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	for _, v := range warnings.All() {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  v.Summary,
+			Detail:   v.Detail,
+		})
+	}
+
+	return response, nil
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -61,13 +62,83 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Can also be configured using the `AWS_CA_BUNDLE` environment variable. " +
 					"(Setting `ca_bundle` in the shared config file is not supported.)",
 			},
-			"default_tags": {
+			"custom_partition": {
 				Type:        schema.TypeList,
 				Optional:    true,
 				MaxItems:    1,
-				Description: "Configuration block with settings to default resource tags across all resources.",
+				Description: "Configuration block defining a partition not in the AWS SDK's endpoint metadata, e.g. an AWS-compatible environment or an isolated Region.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_suffix": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The domain suffix of service endpoints in the partition, e.g. `example.com`.",
+						},
+						"dual_stack_dns_suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The domain suffix of dual-stack service endpoints in the partition. Defaults to `dns_suffix`.",
+						},
+						"fips_dns_suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The domain suffix of FIPS service endpoints in the partition. Defaults to `dns_suffix`.",
+						},
+						"hosted_zone_ids": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: verify.MapKeysAre(
+								validation.ToDiagFunc(validation.StringInSlice(conns.HostedZoneIDKeys(), false)),
+							),
+							Description: "Map of service to the Route 53 hosted zone ID of its endpoints in the partition. " +
+								"Valid keys are `" + strings.Join(conns.HostedZoneIDKeys(), "`, `") + "`.",
+						},
+						names.AttrID: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The partition ID, used in ARNs, e.g. `aws-example`.",
+						},
+						"region_regex": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "Regular expression matching the IDs of Regions in the partition, e.g. `^example-\\w+-\\d+$`.",
+						},
+					},
+				},
+			},
+			"decode_authorization_messages": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to decode encoded authorization failure messages in error messages using STS DecodeAuthorizationMessage. " +
+					"Requires the `sts:DecodeAuthorizationMessage` permission.",
+			},
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Configuration blocks with settings to default resource tags across all resources, " +
+					"or across resources of the included and not excluded types.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validResourceTypePattern,
+							},
+							Description: "Resource types, or patterns with `*` wildcards, to which the tags are not defaulted.",
+						},
+						"include_resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validResourceTypePattern,
+							},
+							Description: "Resource types, or patterns with `*` wildcards, to which the tags are defaulted. Defaults to all resource types.",
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -78,6 +149,45 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with default operation timeouts for resource types, overriding resources' compiled-in defaults.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default Create timeout, e.g. `60m`.",
+						},
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default Delete timeout, e.g. `60m`.",
+						},
+						"read": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default Read timeout, e.g. `60m`.",
+						},
+						names.AttrResourceType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validResourceTypePattern,
+							Description:  "The resource type, e.g. `aws_db_instance`, or a pattern with `*` wildcards, e.g. `aws_rds_*`.",
+						},
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default Update timeout, e.g. `60m`.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -109,6 +219,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 					"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_policy_recording_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which an IAM policy document allowing the AWS API actions invoked by the provider is written. " +
+					"Can also be set using the `" + conns.IAMPolicyRecordingPathEnvVar + "` environment variable.",
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -130,6 +246,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions matching resource tag keys to ignore across all resources, e.g. `^kubernetes\\.io/cluster/`.",
+						},
+						"value_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -191,6 +325,84 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_availability_preflight": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to check that the services of planned resource creations are available in the configured Region.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{conns.ServiceAvailabilityPreflightActionError, conns.ServiceAvailabilityPreflightActionWarn}, false),
+							Description: "Whether a plan that creates a resource of a service that isn't available in the Region fails (`ERROR`) or is reported as a warning (`WARN`). " +
+								"Defaults to `WARN`.",
+						},
+						"override": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Regions in which a service is available in addition to those in the provider's service availability data, e.g. for private previews.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"regions": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The Regions in which the service is available. If not set, the service is treated as available in all Regions.",
+									},
+									"service": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The service package, e.g. `bedrock`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_quota_preflight": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to check planned resource creations against service quotas.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{conns.ServiceQuotaPreflightActionError, conns.ServiceQuotaPreflightActionWarn}, false),
+							Description: "Whether a plan that would exceed a service quota fails (`ERROR`) or is reported as a warning (`WARN`). " +
+								"Defaults to `WARN`.",
+						},
+						"quota": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Additional mapping of a resource type to a service quota consumed by each resource of that type.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"quota_code": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The service quota code, e.g. `L-F678F1CE`.",
+									},
+									names.AttrResourceType: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The resource type, e.g. `aws_vpc`.",
+									},
+									"service_code": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The service code, e.g. `vpc`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -264,8 +476,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
+	// compiledTimeouts holds resources' compiled-in default timeouts, keyed by resource type.
+	compiledTimeouts := make(map[string]*schema.ResourceTimeout)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+		meta, diags := configure(ctx, provider, d)
+
+		if meta != nil {
+			setDefaultTimeouts(ctx, provider, compiledTimeouts, meta)
+		}
+
+		return meta, diags
 	}
 
 	var errs []error
@@ -301,7 +522,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        OnError,
+					why:         Read,
+					interceptor: interceptorFunc(decodeAuthorizationMessageInterceptor),
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -369,7 +596,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -377,7 +604,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        OnError,
+					why:         AllOps,
+					interceptor: interceptorFunc(decodeAuthorizationMessageInterceptor),
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -433,6 +666,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
+					// Resources exporting an ARN can also be imported by ARN.
+					if s, ok := r.SchemaMap()[names.AttrARN]; ok && s.Computed && s.Type == schema.TypeString {
+						v = importByARN(typeName, r, v)
+					}
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
@@ -445,6 +682,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				}
 			}
 
+			if r.Timeouts != nil {
+				compiledTimeouts[typeName] = r.Timeouts
+			}
+
 			provider.ResourcesMap[typeName] = r
 		}
 	}
@@ -482,6 +723,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		DecodeAuthorizationMessages:    d.Get("decode_authorization_messages").(bool),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
@@ -559,10 +801,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
-	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 {
+		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})...)
 	} else {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
+		config.DefaultTagsConfig = expandDefaultTags(ctx)
+	}
+
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		config.DefaultTimeouts = expandDefaultTimeouts(v.([]interface{}))
 	}
 
 	v := d.Get("endpoints")
@@ -592,6 +838,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.NoProxy = v
 	}
 
+	if v, ok := d.Get("iam_policy_recording_path").(string); ok && v != "" {
+		config.IAMPolicyRecordingPath = v
+	} else {
+		config.IAMPolicyRecordingPath = os.Getenv(conns.IAMPolicyRecordingPathEnvVar)
+	}
+
+	if v, ok := d.GetOk("custom_partition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.CustomPartition = expandCustomPartition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	} else {
@@ -602,6 +858,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_availability_preflight"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.ServiceAvailabilityPreflightConfig = expandServiceAvailabilityPreflight(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_quota_preflight"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.ServiceQuotaPreflightConfig = expandServiceQuotaPreflight(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -870,7 +1134,39 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
-func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
+func expandDefaultTimeouts(tfList []interface{}) []conns.DefaultTimeouts {
+	var apiObjects []conns.DefaultTimeouts
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := conns.DefaultTimeouts{
+			ResourceType: tfMap[names.AttrResourceType].(string),
+		}
+
+		for k, v := range map[string]*time.Duration{
+			"create": &apiObject.Create,
+			"delete": &apiObject.Delete,
+			"read":   &apiObject.Read,
+			"update": &apiObject.Update,
+		} {
+			if s, ok := tfMap[k].(string); ok && s != "" {
+				*v, _ = time.ParseDuration(s)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandDefaultTags expands `default_tags` configuration blocks.
+// Tags from environment variables and from blocks without resource type rules are defaulted across all resources.
+func expandDefaultTags(ctx context.Context, tfList ...interface{}) *tftags.DefaultConfig {
 	tags := make(map[string]interface{})
 	for _, ev := range os.Environ() {
 		k, v, _ := strings.Cut(ev, "=")
@@ -879,15 +1175,44 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		}
 	}
 
-	if cfgTags, ok := tfMap["tags"].(map[string]interface{}); ok {
-		for k, v := range cfgTags {
-			tags[k] = v
+	var scoped []tftags.ScopedDefaultConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		cfgTags, _ := tfMap["tags"].(map[string]interface{})
+
+		var includeResourceTypes, excludeResourceTypes []string
+		if v, ok := tfMap["include_resource_types"].(*schema.Set); ok {
+			includeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+			excludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if len(includeResourceTypes) == 0 && len(excludeResourceTypes) == 0 {
+			for k, v := range cfgTags {
+				tags[k] = v
+			}
+			continue
+		}
+
+		if len(cfgTags) > 0 {
+			scoped = append(scoped, tftags.ScopedDefaultConfig{
+				Tags:                 tftags.New(ctx, cfgTags),
+				IncludeResourceTypes: includeResourceTypes,
+				ExcludeResourceTypes: excludeResourceTypes,
+			})
 		}
 	}
 
-	if len(tags) > 0 {
+	if len(tags) > 0 || len(scoped) > 0 {
 		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+			Tags:   tftags.New(ctx, tags),
+			Scoped: scoped,
 		}
 	}
 
@@ -896,6 +1221,7 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
+	var keyRegexes, valueRegexes []*regexp.Regexp
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -904,6 +1230,12 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+			keyRegexes = expandRegexes(flex.ExpandStringValueSet(v))
+		}
+		if v, ok := tfMap["value_regexes"].(*schema.Set); ok {
+			valueRegexes = expandRegexes(flex.ExpandStringValueSet(v))
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -924,14 +1256,17 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 
 	// To preseve behavior prior to supporting environment variables:
 	//
-	// - Return nil when no keys or prefixes are set
+	// - Return nil when no keys, prefixes or regexes are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keyRegexes) == 0 && len(valueRegexes) == 0 {
 		return nil
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		KeyRegexes:   keyRegexes,
+		ValueRegexes: valueRegexes,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
@@ -942,6 +1277,98 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRegexes(patterns []string) []*regexp.Regexp {
+	var regexes []*regexp.Regexp
+
+	for _, v := range patterns {
+		regexes = append(regexes, regexache.MustCompile(v))
+	}
+
+	return regexes
+}
+
+func expandCustomPartition(tfMap map[string]interface{}) *conns.CustomPartition {
+	partition := &conns.CustomPartition{
+		ID:                 tfMap[names.AttrID].(string),
+		RegionRegex:        regexache.MustCompile(tfMap["region_regex"].(string)),
+		DNSSuffix:          tfMap["dns_suffix"].(string),
+		DualStackDNSSuffix: tfMap["dual_stack_dns_suffix"].(string),
+		FIPSDNSSuffix:      tfMap["fips_dns_suffix"].(string),
+	}
+
+	if v, ok := tfMap["hosted_zone_ids"].(map[string]interface{}); ok && len(v) > 0 {
+		partition.HostedZoneIDs = flex.ExpandStringValueMap(v)
+	}
+
+	return partition
+}
+
+func expandServiceAvailabilityPreflight(tfMap map[string]interface{}) *conns.ServiceAvailabilityPreflightConfig {
+	config := &conns.ServiceAvailabilityPreflightConfig{
+		Action:    conns.ServiceAvailabilityPreflightActionWarn,
+		Overrides: make(map[string][]string),
+	}
+
+	if v, ok := tfMap[names.AttrAction].(string); ok && v != "" {
+		config.Action = v
+	}
+
+	if v, ok := tfMap["override"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			service := tfMap["service"].(string)
+			if v, ok := config.Overrides[service]; ok && len(v) == 0 {
+				// Already available in all Regions.
+				continue
+			}
+
+			var regions []string
+			if v, ok := tfMap["regions"].(*schema.Set); ok {
+				regions = flex.ExpandStringValueSet(v)
+			}
+
+			if len(regions) == 0 {
+				config.Overrides[service] = []string{}
+			} else {
+				config.Overrides[service] = append(config.Overrides[service], regions...)
+			}
+		}
+	}
+
+	return config
+}
+
+func expandServiceQuotaPreflight(tfMap map[string]interface{}) *conns.ServiceQuotaPreflightConfig {
+	config := &conns.ServiceQuotaPreflightConfig{
+		Action: conns.ServiceQuotaPreflightActionWarn,
+	}
+
+	if v, ok := tfMap[names.AttrAction].(string); ok && v != "" {
+		config.Action = v
+	}
+
+	if v, ok := tfMap["quota"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			config.Quotas = append(config.Quotas, conns.ServiceQuotaPreflightQuota{
+				QuotaCode:    tfMap["quota_code"].(string),
+				ResourceType: tfMap[names.AttrResourceType].(string),
+				ServiceCode:  tfMap["service_code"].(string),
+			})
+		}
+	}
+
+	return config
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
@@ -6,13 +6,18 @@ package provider
 import (
 	"context"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
 	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
 	tftags "github.com/isometry/terraform-provider-faws/internal/tags"
 	"github.com/isometry/terraform-provider-faws/names"
@@ -235,6 +240,7 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
 		tags                  map[string]interface{}
+		scoped                []interface{}
 		envvars               map[string]string
 		expectedDefaultConfig *tftags.DefaultConfig
 	}{
@@ -280,6 +286,69 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				}),
 			},
 		},
+		"scoped": {
+			tags: map[string]interface{}{
+				"Owner": "my-team",
+			},
+			scoped: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"Backup": "daily",
+					},
+					"include_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_db_*"}),
+					"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+				},
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"CostCenter": "1234",
+					},
+					"include_resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+					"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_autoscaling_group"}),
+				},
+			},
+			envvars: map[string]string{},
+			expectedDefaultConfig: &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{
+					"Owner": "my-team",
+				}),
+				Scoped: []tftags.ScopedDefaultConfig{
+					{
+						Tags: tftags.New(ctx, map[string]string{
+							"Backup": "daily",
+						}),
+						IncludeResourceTypes: []string{"aws_db_*"},
+					},
+					{
+						Tags: tftags.New(ctx, map[string]string{
+							"CostCenter": "1234",
+						}),
+						ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+					},
+				},
+			},
+		},
+		"scoped only": {
+			scoped: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"Backup": "daily",
+					},
+					"include_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_db_*"}),
+				},
+			},
+			envvars: map[string]string{},
+			expectedDefaultConfig: &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{}),
+				Scoped: []tftags.ScopedDefaultConfig{
+					{
+						Tags: tftags.New(ctx, map[string]string{
+							"Backup": "daily",
+						}),
+						IncludeResourceTypes: []string{"aws_db_*"},
+					},
+				},
+			},
+		},
 	}
 
 	for name, testcase := range testcases { //nolint:paralleltest
@@ -290,9 +359,12 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				os.Setenv(k, v)
 			}
 
-			results := expandDefaultTags(ctx, map[string]interface{}{
+			tfList := []interface{}{map[string]interface{}{
 				"tags": testcase.tags,
-			})
+			}}
+			tfList = append(tfList, testcase.scoped...)
+
+			results := expandDefaultTags(ctx, tfList...)
 
 			if results == nil {
 				if testcase.expectedDefaultConfig == nil {
@@ -302,6 +374,18 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				}
 			} else if !testcase.expectedDefaultConfig.TagsEqual(results.Tags) {
 				t.Errorf("Expected default tags config to be %v, got %v", testcase.expectedDefaultConfig, results)
+			} else if len(results.Scoped) != len(testcase.expectedDefaultConfig.Scoped) {
+				t.Errorf("Expected %d scoped default tags configs, got %d", len(testcase.expectedDefaultConfig.Scoped), len(results.Scoped))
+			} else {
+				for i, want := range testcase.expectedDefaultConfig.Scoped {
+					got := results.Scoped[i]
+					if !want.Tags.ContainsAll(got.Tags) || !got.Tags.ContainsAll(want.Tags) {
+						t.Errorf("Expected scoped default tags config %d tags to be %v, got %v", i, want.Tags, got.Tags)
+					}
+					if !slices.Equal(want.IncludeResourceTypes, got.IncludeResourceTypes) || !slices.Equal(want.ExcludeResourceTypes, got.ExcludeResourceTypes) {
+						t.Errorf("Expected scoped default tags config %d to be %v, got %v", i, want, got)
+					}
+				}
 			}
 		})
 	}
@@ -312,6 +396,8 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	testcases := map[string]struct {
 		keys                 []interface{}
 		keyPrefixes          []interface{}
+		keyRegexes           []interface{}
+		valueRegexes         []interface{}
 		envvars              map[string]string
 		expectedIgnoreConfig *tftags.IgnoreConfig
 	}{
@@ -396,6 +482,25 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 				KeyPrefixes: tftags.New(ctx, []interface{}{"example1", "example2", "example3"}),
 			},
 		},
+		"config regexes": {
+			keyRegexes:   []interface{}{`^kubernetes\.io/cluster/`},
+			valueRegexes: []interface{}{`^arn:[^:]+:backup:`},
+			envvars:      map[string]string{},
+			expectedIgnoreConfig: &tftags.IgnoreConfig{
+				KeyRegexes:   []*regexp.Regexp{regexache.MustCompile(`^kubernetes\.io/cluster/`)},
+				ValueRegexes: []*regexp.Regexp{regexache.MustCompile(`^arn:[^:]+:backup:`)},
+			},
+		},
+		"envvar and config regexes": {
+			keyRegexes: []interface{}{`^kubernetes\.io/cluster/`},
+			envvars: map[string]string{
+				tftags.IgnoreTagsKeysEnvVar: "env1",
+			},
+			expectedIgnoreConfig: &tftags.IgnoreConfig{
+				Keys:       tftags.New(ctx, []interface{}{"env1"}),
+				KeyRegexes: []*regexp.Regexp{regexache.MustCompile(`^kubernetes\.io/cluster/`)},
+			},
+		},
 	}
 
 	for name, testcase := range testcases { //nolint:paralleltest
@@ -407,21 +512,203 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 			}
 
 			results := expandIgnoreTags(ctx, map[string]interface{}{
-				"keys":         schema.NewSet(schema.HashString, testcase.keys),
-				"key_prefixes": schema.NewSet(schema.HashString, testcase.keyPrefixes),
+				"keys":          schema.NewSet(schema.HashString, testcase.keys),
+				"key_prefixes":  schema.NewSet(schema.HashString, testcase.keyPrefixes),
+				"key_regexes":   schema.NewSet(schema.HashString, testcase.keyRegexes),
+				"value_regexes": schema.NewSet(schema.HashString, testcase.valueRegexes),
 			})
 
 			if results == nil && testcase.expectedIgnoreConfig != nil {
 				t.Errorf("Expected ignore tags config to be %v, got nil", testcase.expectedIgnoreConfig)
 			}
 
-			if diff := cmp.Diff(testcase.expectedIgnoreConfig, results); diff != "" {
+			regexpComparer := cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				return x.String() == y.String()
+			})
+			if diff := cmp.Diff(testcase.expectedIgnoreConfig, results, regexpComparer); diff != "" {
 				t.Errorf("Unexpected ignore_tags diff: %s", diff)
 			}
 		})
 	}
 }
 
+func TestExpandServiceQuotaPreflight(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected *conns.ServiceQuotaPreflightConfig
+	}{
+		"empty": {
+			tfMap: map[string]interface{}{
+				names.AttrAction: "",
+				"quota":          []interface{}{},
+			},
+			expected: &conns.ServiceQuotaPreflightConfig{
+				Action: conns.ServiceQuotaPreflightActionWarn,
+			},
+		},
+		"quotas": {
+			tfMap: map[string]interface{}{
+				names.AttrAction: conns.ServiceQuotaPreflightActionError,
+				"quota": []interface{}{
+					map[string]interface{}{
+						"quota_code":           "L-A4707A72",
+						names.AttrResourceType: "aws_internet_gateway",
+						"service_code":         "vpc",
+					},
+				},
+			},
+			expected: &conns.ServiceQuotaPreflightConfig{
+				Action: conns.ServiceQuotaPreflightActionError,
+				Quotas: []conns.ServiceQuotaPreflightQuota{
+					{
+						QuotaCode:    "L-A4707A72",
+						ResourceType: "aws_internet_gateway",
+						ServiceCode:  "vpc",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testcase.expected, expandServiceQuotaPreflight(testcase.tfMap)); diff != "" {
+				t.Errorf("Unexpected service_quota_preflight diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandServiceAvailabilityPreflight(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected *conns.ServiceAvailabilityPreflightConfig
+	}{
+		"empty": {
+			tfMap: map[string]interface{}{
+				names.AttrAction: "",
+				"override":       []interface{}{},
+			},
+			expected: &conns.ServiceAvailabilityPreflightConfig{
+				Action:    conns.ServiceAvailabilityPreflightActionWarn,
+				Overrides: map[string][]string{},
+			},
+		},
+		"overrides": {
+			tfMap: map[string]interface{}{
+				names.AttrAction: conns.ServiceAvailabilityPreflightActionError,
+				"override": []interface{}{
+					map[string]interface{}{
+						"regions": schema.NewSet(schema.HashString, []interface{}{"eu-south-2"}),
+						"service": "bedrock",
+					},
+					map[string]interface{}{
+						"regions": schema.NewSet(schema.HashString, []interface{}{}),
+						"service": "m2",
+					},
+					map[string]interface{}{
+						"regions": schema.NewSet(schema.HashString, []interface{}{"eu-west-1"}), //lintignore:AWSAT003
+						"service": "m2",
+					},
+				},
+			},
+			expected: &conns.ServiceAvailabilityPreflightConfig{
+				Action: conns.ServiceAvailabilityPreflightActionError,
+				Overrides: map[string][]string{
+					"bedrock": {"eu-south-2"},
+					"m2":      {},
+				},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testcase.expected, expandServiceAvailabilityPreflight(testcase.tfMap)); diff != "" {
+				t.Errorf("Unexpected service_availability_preflight diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	tfList := []interface{}{
+		map[string]interface{}{
+			"create":               "2h",
+			"delete":               "",
+			"read":                 "",
+			names.AttrResourceType: "aws_rds_*",
+			"update":               "90m",
+		},
+	}
+	expected := []conns.DefaultTimeouts{
+		{
+			ResourceType: "aws_rds_*",
+			Create:       2 * time.Hour,
+			Update:       90 * time.Minute,
+		},
+	}
+
+	if diff := cmp.Diff(expected, expandDefaultTimeouts(tfList)); diff != "" {
+		t.Errorf("Unexpected default_timeouts diff: %s", diff)
+	}
+}
+
+func TestSetDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	compiled := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(40 * time.Minute),
+		Delete: schema.DefaultTimeout(40 * time.Minute),
+	}
+	r := &schema.Resource{
+		Timeouts: compiled,
+	}
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_db_instance": r,
+		},
+	}
+	compiledTimeouts := map[string]*schema.ResourceTimeout{
+		"aws_db_instance": compiled,
+	}
+
+	var meta conns.AWSClient
+	conns.SetDefaultTimeouts(&meta, []conns.DefaultTimeouts{
+		{
+			ResourceType: "aws_db_*",
+			Create:       2 * time.Hour,
+			Update:       2 * time.Hour,
+		},
+	})
+
+	setDefaultTimeouts(ctx, provider, compiledTimeouts, &meta)
+
+	if got, expected := *r.Timeouts.Create, 2*time.Hour; got != expected {
+		t.Errorf("Create timeout: got %s, expected %s", got, expected)
+	}
+	if got, expected := *r.Timeouts.Delete, 40*time.Minute; got != expected {
+		t.Errorf("Delete timeout: got %s, expected %s", got, expected)
+	}
+	if r.Timeouts.Update != nil {
+		t.Errorf("Update timeout: got %s, expected none", *r.Timeouts.Update)
+	}
+	if got, expected := *compiled.Create, 40*time.Minute; got != expected {
+		t.Errorf("compiled-in Create timeout: got %s, expected %s", got, expected)
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
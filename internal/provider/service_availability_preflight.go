@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+// serviceAvailabilityPreflightServer wraps the muxed provider server,
+// checking that the services of planned resource creations are available in the configured Region
+// when enabled by the provider's `service_availability_preflight` configuration block.
+type serviceAvailabilityPreflightServer struct {
+	tfprotov5.ProviderServer
+
+	primary *schema.Provider
+	// resourceServicePackages maps resource type names to service package names.
+	resourceServicePackages map[string]string
+}
+
+func newServiceAvailabilityPreflightServer(ctx context.Context, primary *schema.Provider, server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	resourceServicePackages := make(map[string]string)
+
+	for _, sp := range servicePackages(ctx) {
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.SDKResources(ctx) {
+			resourceServicePackages[v.TypeName] = servicePackageName
+		}
+		for _, v := range sp.FrameworkResources(ctx) {
+			resourceServicePackages[v.TypeName] = servicePackageName
+		}
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return &serviceAvailabilityPreflightServer{
+			ProviderServer:          server(),
+			primary:                 primary,
+			resourceServicePackages: resourceServicePackages,
+		}
+	}
+}
+
+func (s *serviceAvailabilityPreflightServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return response, nil
+		}
+	}
+
+	// Only resource creations are checked: an existing resource's service is evidently available.
+	if !isNullDynamicValue(request.PriorState) || isNullDynamicValue(response.PlannedState) {
+		return response, nil
+	}
+
+	client, ok := s.primary.Meta().(*conns.AWSClient)
+	if !ok {
+		return response, nil
+	}
+
+	config := client.ServiceAvailabilityPreflightConfig(ctx)
+	if config == nil {
+		return response, nil
+	}
+
+	servicePackageName, ok := s.resourceServicePackages[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	partition, region := client.Partition(ctx), client.Region(ctx)
+	if available, known := config.ServiceAvailable(servicePackageName, partition, region); available || !known {
+		return response, nil
+	}
+
+	severity := tfprotov5.DiagnosticSeverityWarning
+	if config.Action == conns.ServiceAvailabilityPreflightActionError {
+		severity = tfprotov5.DiagnosticSeverityError
+	}
+
+	serviceName, err := names.FullHumanFriendly(servicePackageName)
+	if err != nil {
+		serviceName = servicePackageName
+	}
+
+	response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+		Severity: severity,
+		Summary:  "Service not available in Region",
+		Detail: fmt.Sprintf("%s (%s) is not available in Region %s, according to the AWS SDK endpoint metadata included in this provider version, so creating %s is likely to fail.\n\n"+
+			"If the service is available to your account in this Region, add a `service_availability_preflight` `override` for %q.",
+			serviceName, servicePackageName, region, request.TypeName, servicePackageName),
+	})
+
+	return response, nil
+}
+
+// isNullDynamicValue returns whether the value is absent or a msgpack-encoded null, without decoding it against a schema.
+func isNullDynamicValue(v *tfprotov5.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+
+	if len(v.MsgPack) > 0 {
+		return bytes.Equal(v.MsgPack, []byte{0xc0}) // msgpack nil.
+	}
+
+	return len(v.JSON) == 0 || bytes.Equal(bytes.TrimSpace(v.JSON), []byte("null"))
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	tfservicequotas "github.com/isometry/terraform-provider-faws/internal/service/servicequotas"
+)
+
+// serviceQuotaPreflightServer wraps the muxed provider server,
+// checking planned resource creations against service quotas when enabled by the
+// provider's `service_quota_preflight` configuration block.
+type serviceQuotaPreflightServer struct {
+	tfprotov5.ProviderServer
+
+	primary   *schema.Provider
+	preflight *tfservicequotas.Preflight
+
+	// resourceTypes caches the value types of resources' schemas, resolved through the muxed server
+	// so that both Plugin SDK and Plugin Framework resources can be decoded.
+	resourceTypesLock sync.Mutex
+	resourceTypes     map[string]cty.Type
+}
+
+func newServiceQuotaPreflightServer(primary *schema.Provider, server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &serviceQuotaPreflightServer{
+			ProviderServer: server(),
+			primary:        primary,
+			preflight:      tfservicequotas.NewPreflight(),
+		}
+	}
+}
+
+func (s *serviceQuotaPreflightServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return response, nil
+		}
+	}
+
+	// Only resource creations consume additional quota.
+	if !isNullDynamicValue(request.PriorState) || isNullDynamicValue(response.PlannedState) {
+		return response, nil
+	}
+
+	client, ok := s.primary.Meta().(*conns.AWSClient)
+	if !ok {
+		return response, nil
+	}
+
+	config := client.ServiceQuotaPreflightConfig(ctx)
+	if config == nil {
+		return response, nil
+	}
+
+	ty, err := s.resourceType(ctx, request.TypeName)
+	if err != nil {
+		tflog.Warn(ctx, "skipping Service Quotas preflight check: reading resource schema", map[string]any{
+			"error": err.Error(),
+		})
+		return response, nil
+	}
+
+	planned, err := decodeDynamicValue(response.PlannedState, ty)
+	if err != nil {
+		tflog.Warn(ctx, "skipping Service Quotas preflight check: decoding planned state", map[string]any{
+			"error": err.Error(),
+		})
+		return response, nil
+	}
+
+	severity := tfprotov5.DiagnosticSeverityWarning
+	if config.Action == conns.ServiceQuotaPreflightActionError {
+		severity = tfprotov5.DiagnosticSeverityError
+	}
+
+	for _, message := range s.preflight.Check(ctx, client, request.TypeName, planned) {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: severity,
+			Summary:  "Service quota would be exceeded",
+			Detail:   message,
+		})
+	}
+
+	return response, nil
+}
+
+// resourceType returns the value type of the specified resource type's schema.
+func (s *serviceQuotaPreflightServer) resourceType(ctx context.Context, typeName string) (cty.Type, error) {
+	s.resourceTypesLock.Lock()
+	defer s.resourceTypesLock.Unlock()
+
+	if s.resourceTypes == nil {
+		response, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+		if err != nil {
+			return cty.NilType, err
+		}
+
+		resourceTypes := make(map[string]cty.Type, len(response.ResourceSchemas))
+		for k, v := range response.ResourceSchemas {
+			ty, err := schemaImpliedType(v)
+
+			if err != nil {
+				return cty.NilType, fmt.Errorf("%s: %w", k, err)
+			}
+
+			resourceTypes[k] = ty
+		}
+
+		s.resourceTypes = resourceTypes
+	}
+
+	ty, ok := s.resourceTypes[typeName]
+	if !ok {
+		return cty.NilType, fmt.Errorf("no schema for resource type %s", typeName)
+	}
+
+	return ty, nil
+}
+
+// schemaImpliedType converts a protocol schema's value type to a cty type.
+func schemaImpliedType(v *tfprotov5.Schema) (cty.Type, error) {
+	b, err := json.Marshal(v.ValueType())
+
+	if err != nil {
+		return cty.NilType, err
+	}
+
+	return ctyjson.UnmarshalType(b)
+}
+
+func decodeDynamicValue(v *tfprotov5.DynamicValue, ty cty.Type) (cty.Value, error) {
+	if v == nil || len(v.MsgPack) == 0 {
+		return cty.NullVal(ty), nil
+	}
+
+	return msgpack.Unmarshal(v.MsgPack, ty)
+}
@@ -5,6 +5,8 @@ package provider
 
 import (
 	"fmt"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -28,6 +30,22 @@ func validAssumeRoleDuration(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// validResourceTypePattern validates a resource type name or a pattern matching resource type names, e.g. `aws_rds_*`.
+func validResourceTypePattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !strings.HasPrefix(value, "aws_") && !strings.HasPrefix(value, "*") {
+		errors = append(errors, fmt.Errorf("%q must be a resource type or a pattern matching resource types, e.g. aws_rds_*, got: %s", k, value))
+		return
+	}
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a pattern: %w", k, err))
+	}
+
+	return
+}
+
 var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
@@ -73,3 +73,46 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidResourceTypePattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: "aws_db_instance",
+		},
+		{
+			val: "aws_rds_*",
+		},
+		{
+			val: "*",
+		},
+		{
+			val:         "db_instance",
+			expectedErr: regexache.MustCompile(`must be a resource type or a pattern matching resource types`),
+		},
+		{
+			val:         "aws_[rds_*",
+			expectedErr: regexache.MustCompile(`cannot be parsed as a pattern`),
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validResourceTypePattern(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
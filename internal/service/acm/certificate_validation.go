@@ -21,6 +21,7 @@ import (
 	"github.com/isometry/terraform-provider-faws/internal/enum"
 	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
 	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/internal/verify"
 	"github.com/isometry/terraform-provider-faws/names"
 )
 
@@ -29,7 +30,7 @@ func resourceCertificateValidation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCertificateValidationCreate,
 		ReadWithoutTimeout:   resourceCertificateValidationRead,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceCertificateValidationDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
@@ -41,6 +42,58 @@ func resourceCertificateValidation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"route53_record": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"route53_zone": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDomainName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							StateFunc: func(v interface{}) string {
+								return normalizeCertificateValidationDomain(v.(string))
+							},
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"validation_record_fqdns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -67,6 +120,51 @@ func resourceCertificateValidationCreate(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "ACM Certificate (%s) has type %s, no validation necessary", arn, v)
 	}
 
+	var zones []certificateValidationZone
+	var created []certificateValidationRecord
+
+	// The resource isn't saved to state on error, so remove any validation records it created.
+	deleteCreatedRecords := func(diags diag.Diagnostics) diag.Diagnostics {
+		if err := deleteCertificateValidationRecords(ctx, meta.(*conns.AWSClient), zones, created); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "deleting ACM Certificate (%s) validation records: %s", arn, err)
+		}
+
+		return diags
+	}
+
+	if v, ok := d.GetOk("route53_zone"); ok && v.(*schema.Set).Len() > 0 {
+		zones = expandCertificateValidationZones(v.(*schema.Set).List())
+
+		// DNS validation records are added to the certificate shortly after it is requested.
+		if err := tfresource.WaitUntil(ctx, d.Timeout(schema.TimeoutCreate), func() (bool, error) {
+			certificate, err = findCertificateByARN(ctx, conn, arn)
+
+			if err != nil {
+				return false, err
+			}
+
+			return certificateValidationRecordsAvailable(certificate), nil
+		}, tfresource.WaitOpts{}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) validation records: %s", arn, err)
+		}
+
+		records, err := certificateValidationRecords(certificate.DomainValidationOptions, zones)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "ACM Certificate (%s) validation: %s", arn, err)
+		}
+
+		created, err = createCertificateValidationRecords(ctx, meta.(*conns.AWSClient), zones, records)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(deleteCreatedRecords(diags), "creating ACM Certificate (%s) validation records: %s", arn, err)
+		}
+
+		if err := d.Set("route53_record", flattenCertificateValidationRecords(created)); err != nil {
+			return sdkdiag.AppendErrorf(deleteCreatedRecords(diags), "setting route53_record: %s", err)
+		}
+	}
+
 	if v, ok := d.GetOk("validation_record_fqdns"); ok && v.(*schema.Set).Len() > 0 {
 		fqdns := make(map[string]types.DomainValidation)
 
@@ -93,12 +191,12 @@ func resourceCertificateValidationCreate(ctx context.Context, d *schema.Resource
 				errs = append(errs, fmt.Errorf("missing %s DNS validation record: %s", aws.ToString(domainValidation.DomainName), fqdn))
 			}
 
-			return sdkdiag.AppendFromErr(diags, errors.Join(errs...))
+			return sdkdiag.AppendFromErr(deleteCreatedRecords(diags), errors.Join(errs...))
 		}
 	}
 
 	if _, err := waitCertificateIssued(ctx, conn, arn, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) to be issued: %s", arn, err)
+		return sdkdiag.AppendErrorf(deleteCreatedRecords(diags), "waiting for ACM Certificate (%s) to be issued: %s", arn, err)
 	}
 
 	d.SetId(aws.ToTime(certificate.IssuedAt).String())
@@ -129,6 +227,25 @@ func resourceCertificateValidationRead(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+func resourceCertificateValidationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, ok := d.GetOk("route53_record")
+	if !ok || v.(*schema.Set).Len() == 0 {
+		return diags
+	}
+
+	zones := expandCertificateValidationZones(d.Get("route53_zone").(*schema.Set).List())
+	records := expandCertificateValidationRecords(v.(*schema.Set).List())
+
+	log.Printf("[DEBUG] Deleting ACM Certificate (%s) validation records", d.Get(names.AttrCertificateARN).(string))
+	if err := deleteCertificateValidationRecords(ctx, meta.(*conns.AWSClient), zones, records); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ACM Certificate (%s) validation records: %s", d.Get(names.AttrCertificateARN).(string), err)
+	}
+
+	return diags
+}
+
 func findCertificateValidationByARN(ctx context.Context, conn *acm.Client, arn string) (*types.CertificateDetail, error) {
 	output, err := findCertificateByARN(ctx, conn, arn)
 
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acm
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	certificateValidationRecordTTL = 60
+)
+
+// certificateValidationZone is a Route 53 hosted zone in which validation records are created.
+type certificateValidationZone struct {
+	domainName string
+	roleARN    string
+	zoneID     string
+}
+
+// certificateValidationRecord is a DNS validation record created in a Route 53 hosted zone.
+type certificateValidationRecord struct {
+	name   string
+	typ    string
+	value  string
+	zoneID string
+}
+
+func normalizeCertificateValidationDomain(v string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(v, "*."), "."))
+}
+
+// certificateValidationRecordsAvailable returns whether every DNS domain validation option has a validation record.
+func certificateValidationRecordsAvailable(certificate *types.CertificateDetail) bool {
+	for _, v := range certificate.DomainValidationOptions {
+		if v.ValidationMethod == types.ValidationMethodDns && v.ValidationStatus != types.DomainStatusSuccess && v.ResourceRecord == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// certificateValidationRecords returns the validation records for the specified domain validation options.
+// Domains that share a validation record, such as a domain and its wildcard, result in a single record.
+// Each record is placed in the zone with the longest domain name matching the domain being validated.
+func certificateValidationRecords(domainValidations []types.DomainValidation, zones []certificateValidationZone) ([]certificateValidationRecord, error) {
+	records := make(map[string]certificateValidationRecord)
+	var matchErrs []error
+
+	for _, v := range domainValidations {
+		if v.ValidationMethod != types.ValidationMethodDns || v.ResourceRecord == nil {
+			continue
+		}
+
+		domainName := normalizeCertificateValidationDomain(aws.ToString(v.DomainName))
+		name := strings.ToLower(aws.ToString(v.ResourceRecord.Name))
+
+		if _, ok := records[name]; ok {
+			continue
+		}
+
+		var zone *certificateValidationZone
+		for _, z := range zones {
+			if domainName != z.domainName && !strings.HasSuffix(domainName, "."+z.domainName) {
+				continue
+			}
+
+			if zone == nil || len(z.domainName) > len(zone.domainName) {
+				zone = &z
+			}
+		}
+
+		if zone == nil {
+			matchErrs = append(matchErrs, fmt.Errorf("no route53_zone matches domain %s", aws.ToString(v.DomainName)))
+			continue
+		}
+
+		records[name] = certificateValidationRecord{
+			name:   name,
+			typ:    string(v.ResourceRecord.Type),
+			value:  aws.ToString(v.ResourceRecord.Value),
+			zoneID: zone.zoneID,
+		}
+	}
+
+	if err := errors.Join(matchErrs...); err != nil {
+		return nil, err
+	}
+
+	output := make([]certificateValidationRecord, 0, len(records))
+	for _, v := range records {
+		output = append(output, v)
+	}
+
+	slices.SortFunc(output, func(a, b certificateValidationRecord) int {
+		return cmp.Compare(a.name, b.name)
+	})
+
+	return output, nil
+}
+
+// certificateValidationRoute53Client returns a Route 53 client for the specified zone,
+// assuming the zone's IAM role if one is configured.
+func certificateValidationRoute53Client(ctx context.Context, c *conns.AWSClient, zones []certificateValidationZone, zoneID string) *route53.Client {
+	conn := c.Route53Client(ctx)
+
+	for _, v := range zones {
+		if v.zoneID != zoneID || v.roleARN == "" {
+			continue
+		}
+
+		options := conn.Options()
+		options.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(c.STSClient(ctx), v.roleARN))
+
+		return route53.New(options)
+	}
+
+	return conn
+}
+
+// createCertificateValidationRecords creates the specified records one at a time and returns those created.
+// A record that already exists with the same value, e.g. one managed by an `aws_route53_record` resource
+// or shared with another certificate for the same domain, satisfies validation but is not owned,
+// so that it is not deleted with this resource.
+func createCertificateValidationRecords(ctx context.Context, c *conns.AWSClient, zones []certificateValidationZone, records []certificateValidationRecord) ([]certificateValidationRecord, error) {
+	var created []certificateValidationRecord
+
+	for _, v := range records {
+		conn := certificateValidationRoute53Client(ctx, c, zones, v.zoneID)
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53types.ChangeBatch{
+				Changes: []route53types.Change{{
+					Action:            route53types.ChangeActionCreate,
+					ResourceRecordSet: v.resourceRecordSet(),
+				}},
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(v.zoneID),
+		}
+
+		_, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if errs.IsAErrorMessageContains[*route53types.InvalidChangeBatch](err, "already exists") {
+			value, err := findCertificateValidationRecordValue(ctx, conn, v)
+
+			if err != nil {
+				return created, fmt.Errorf("reading Route 53 Record (%s) in Hosted Zone (%s): %w", v.name, v.zoneID, err)
+			}
+
+			if !strings.EqualFold(strings.TrimSuffix(value, "."), strings.TrimSuffix(v.value, ".")) {
+				return created, fmt.Errorf("existing Route 53 Record (%s) in Hosted Zone (%s) has value %q, expected %q", v.name, v.zoneID, value, v.value)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return created, fmt.Errorf("creating Route 53 Record (%s) in Hosted Zone (%s): %w", v.name, v.zoneID, err)
+		}
+
+		created = append(created, v)
+	}
+
+	return created, nil
+}
+
+// findCertificateValidationRecordValue returns the value of the existing record with the specified record's name and type.
+func findCertificateValidationRecordValue(ctx context.Context, conn *route53.Client, record certificateValidationRecord) (string, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(record.zoneID),
+		MaxItems:        aws.Int32(1),
+		StartRecordName: aws.String(record.name),
+		StartRecordType: route53types.RRType(record.typ),
+	}
+
+	output, err := conn.ListResourceRecordSets(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range output.ResourceRecordSets {
+		if !strings.EqualFold(strings.TrimSuffix(aws.ToString(v.Name), "."), strings.TrimSuffix(record.name, ".")) || string(v.Type) != record.typ {
+			continue
+		}
+
+		for _, v := range v.ResourceRecords {
+			return aws.ToString(v.Value), nil
+		}
+	}
+
+	return "", errors.New("record not found")
+}
+
+// deleteCertificateValidationRecords deletes the specified records one at a time,
+// so that a record that has already been deleted does not prevent the deletion of the others.
+func deleteCertificateValidationRecords(ctx context.Context, c *conns.AWSClient, zones []certificateValidationZone, records []certificateValidationRecord) error {
+	var deleteErrs []error
+
+	for _, v := range records {
+		conn := certificateValidationRoute53Client(ctx, c, zones, v.zoneID)
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53types.ChangeBatch{
+				Changes: []route53types.Change{{
+					Action:            route53types.ChangeActionDelete,
+					ResourceRecordSet: v.resourceRecordSet(),
+				}},
+			},
+			HostedZoneId: aws.String(v.zoneID),
+		}
+
+		_, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if errs.IsAErrorMessageContains[*route53types.InvalidChangeBatch](err, "not found") {
+			continue
+		}
+
+		if errs.IsA[*route53types.NoSuchHostedZone](err) {
+			continue
+		}
+
+		if err != nil {
+			deleteErrs = append(deleteErrs, fmt.Errorf("deleting Route 53 Record (%s) in Hosted Zone (%s): %w", v.name, v.zoneID, err))
+		}
+	}
+
+	return errors.Join(deleteErrs...)
+}
+
+func (r certificateValidationRecord) resourceRecordSet() *route53types.ResourceRecordSet {
+	return &route53types.ResourceRecordSet{
+		Name: aws.String(r.name),
+		ResourceRecords: []route53types.ResourceRecord{{
+			Value: aws.String(r.value),
+		}},
+		TTL:  aws.Int64(certificateValidationRecordTTL),
+		Type: route53types.RRType(r.typ),
+	}
+}
+
+func expandCertificateValidationZones(tfList []interface{}) []certificateValidationZone {
+	var apiObjects []certificateValidationZone
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, certificateValidationZone{
+			domainName: normalizeCertificateValidationDomain(tfMap[names.AttrDomainName].(string)),
+			roleARN:    tfMap[names.AttrRoleARN].(string),
+			zoneID:     tfMap["zone_id"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandCertificateValidationRecords(tfList []interface{}) []certificateValidationRecord {
+	var apiObjects []certificateValidationRecord
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, certificateValidationRecord{
+			name:   tfMap[names.AttrName].(string),
+			typ:    tfMap[names.AttrType].(string),
+			value:  tfMap[names.AttrValue].(string),
+			zoneID: tfMap["zone_id"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenCertificateValidationRecords(apiObjects []certificateValidationRecord) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:  apiObject.name,
+			names.AttrType:  apiObject.typ,
+			names.AttrValue: apiObject.value,
+			"zone_id":       apiObject.zoneID,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCertificateValidationRecords(t *testing.T) {
+	t.Parallel()
+
+	dnsValidation := func(domainName, recordName string) types.DomainValidation {
+		return types.DomainValidation{
+			DomainName: aws.String(domainName),
+			ResourceRecord: &types.ResourceRecord{
+				Name:  aws.String(recordName),
+				Type:  types.RecordTypeCname,
+				Value: aws.String(recordName + "acm-validations.aws."),
+			},
+			ValidationMethod: types.ValidationMethodDns,
+		}
+	}
+	zones := []certificateValidationZone{
+		{domainName: "example.com", zoneID: "Z1"},
+		{domainName: "dev.example.com", roleARN: "arn:aws:iam::123456789012:role/dns", zoneID: "Z2"},
+	}
+
+	testCases := map[string]struct {
+		domainValidations []types.DomainValidation
+		want              []certificateValidationRecord
+		wantErr           bool
+	}{
+		"deduplicated": {
+			domainValidations: []types.DomainValidation{
+				dnsValidation("example.com", "_a.example.com."),
+				dnsValidation("*.example.com", "_a.example.com."),
+			},
+			want: []certificateValidationRecord{
+				{name: "_a.example.com.", typ: "CNAME", value: "_a.example.com.acm-validations.aws.", zoneID: "Z1"},
+			},
+		},
+		"longest match": {
+			domainValidations: []types.DomainValidation{
+				dnsValidation("www.example.com", "_b.www.example.com."),
+				dnsValidation("api.dev.example.com", "_c.api.dev.example.com."),
+				dnsValidation("dev.example.com", "_d.dev.example.com."),
+			},
+			want: []certificateValidationRecord{
+				{name: "_b.www.example.com.", typ: "CNAME", value: "_b.www.example.com.acm-validations.aws.", zoneID: "Z1"},
+				{name: "_c.api.dev.example.com.", typ: "CNAME", value: "_c.api.dev.example.com.acm-validations.aws.", zoneID: "Z2"},
+				{name: "_d.dev.example.com.", typ: "CNAME", value: "_d.dev.example.com.acm-validations.aws.", zoneID: "Z2"},
+			},
+		},
+		"email validation ignored": {
+			domainValidations: []types.DomainValidation{
+				{DomainName: aws.String("example.org"), ValidationMethod: types.ValidationMethodEmail},
+			},
+			want: []certificateValidationRecord{},
+		},
+		"no matching zone": {
+			domainValidations: []types.DomainValidation{
+				dnsValidation("example.org", "_e.example.org."),
+				dnsValidation("notexample.com", "_f.notexample.com."),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := certificateValidationRecords(testCase.domainValidations, zones)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(certificateValidationRecord{})); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
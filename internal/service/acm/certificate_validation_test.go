@@ -233,6 +233,35 @@ func TestAccACMCertificateValidation_validationRecordFQDNSWildcardAndRoot(t *tes
 	})
 }
 
+func TestAccACMCertificateValidation_route53Zone(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	wildcardDomain := fmt.Sprintf("*.%s", domain)
+	certificateResourceName := "aws_acm_certificate.test"
+	resourceName := "aws_acm_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateValidationConfig_route53Zone(rootDomain, domain, wildcardDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrCertificateARN, certificateResourceName, names.AttrARN),
+					// The domain and its wildcard share a validation record.
+					resource.TestCheckResourceAttr(resourceName, "route53_record.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route53_record.*.zone_id", "data.aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "route53_zone.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCertificateValidationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -461,3 +490,27 @@ resource "aws_acm_certificate_validation" "test" {
 }
 `, domainName)
 }
+
+func testAccCertificateValidationConfig_route53Zone(rootZoneDomain, domainName, subjectAlternativeName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[1]q
+  subject_alternative_names = [%[2]q]
+  validation_method         = "DNS"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[3]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+
+  route53_zone {
+    domain_name = data.aws_route53_zone.test.name
+    zone_id     = data.aws_route53_zone.test.zone_id
+  }
+}
+`, domainName, subjectAlternativeName, rootZoneDomain)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
 	"github.com/isometry/terraform-provider-faws/internal/framework"
 	"github.com/isometry/terraform-provider-faws/names"
 )
@@ -70,7 +71,10 @@ func (d *hostedZoneIDDataSource) Read(ctx context.Context, request datasource.Re
 		region = data.Region.ValueString()
 	}
 
-	if zoneID, ok := hostedZoneIDPerRegionMap[region]; ok {
+	if zoneID, ok := d.Meta().CustomPartitionHostedZoneID(ctx, region, conns.HostedZoneIDKeyAppRunner); ok {
+		data.ID = types.StringValue(zoneID)
+		data.Region = types.StringValue(region)
+	} else if zoneID, ok := hostedZoneIDPerRegionMap[region]; ok {
 		data.ID = types.StringValue(zoneID)
 		data.Region = types.StringValue(region)
 	} else {
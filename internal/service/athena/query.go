@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+	fwflex "github.com/isometry/terraform-provider-faws/internal/framework/flex"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+)
+
+const (
+	queryDefaultTimeout   = 10 * time.Minute
+	queryDefaultWorkGroup = "primary"
+)
+
+var queryRowsElemType = types.MapType{ElemType: types.StringType}
+
+// queryModel is the model shared by the aws_athena_query data source and ephemeral resource.
+type queryModel struct {
+	Catalog             types.String                                      `tfsdk:"catalog"`
+	Columns             fwtypes.ListNestedObjectValueOf[queryColumnModel] `tfsdk:"columns"`
+	Database            types.String                                      `tfsdk:"database"`
+	ExecutionParameters fwtypes.ListValueOf[types.String]                 `tfsdk:"execution_parameters"`
+	MaxRows             types.Int64                                       `tfsdk:"max_rows"`
+	OutputLocation      types.String                                      `tfsdk:"output_location"`
+	QueryExecutionID    types.String                                      `tfsdk:"query_execution_id"`
+	QueryString         types.String                                      `tfsdk:"query_string"`
+	Rows                types.List                                        `tfsdk:"rows"`
+	WorkGroup           types.String                                      `tfsdk:"workgroup"`
+}
+
+type queryColumnModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// runQuery runs the Athena query described by data, waits for it to succeed and reads its results.
+func runQuery(ctx context.Context, conn *athena.Client, data *queryModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if data.WorkGroup.IsNull() {
+		data.WorkGroup = types.StringValue(queryDefaultWorkGroup)
+	}
+
+	input := athena.StartQueryExecutionInput{
+		ExecutionParameters: fwflex.ExpandFrameworkStringValueList(ctx, data.ExecutionParameters),
+		QueryString:         fwflex.StringFromFramework(ctx, data.QueryString),
+		WorkGroup:           fwflex.StringFromFramework(ctx, data.WorkGroup),
+	}
+
+	if !data.Catalog.IsNull() || !data.Database.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Catalog:  fwflex.StringFromFramework(ctx, data.Catalog),
+			Database: fwflex.StringFromFramework(ctx, data.Database),
+		}
+	}
+
+	if !data.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: fwflex.StringFromFramework(ctx, data.OutputLocation),
+		}
+	}
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+
+	if err != nil {
+		diags.AddError("starting Athena query execution", err.Error())
+		return diags
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+
+	queryExecution, err := waitQueryExecutionSucceeded(ctx, conn, queryExecutionID, timeout)
+
+	if err != nil {
+		if !errs.IsA[*retry.UnexpectedStateError](err) {
+			if _, err := conn.StopQueryExecution(context.WithoutCancel(ctx), &athena.StopQueryExecutionInput{QueryExecutionId: aws.String(queryExecutionID)}); err != nil {
+				tflog.Debug(ctx, "stopping Athena query execution", map[string]any{
+					"query_execution_id": queryExecutionID,
+					"error":              err.Error(),
+				})
+			}
+		}
+
+		diags.AddError(fmt.Sprintf("waiting for Athena query execution (%s) success", queryExecutionID), err.Error())
+		return diags
+	}
+
+	columns, rows, err := findQueryResultsByID(ctx, conn, queryExecutionID, queryExecution.StatementType, int(data.MaxRows.ValueInt64()))
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Athena query execution (%s) results", queryExecutionID), err.Error())
+		return diags
+	}
+
+	data.QueryExecutionID = types.StringValue(queryExecutionID)
+
+	data.Columns = flattenQueryColumns(ctx, columns)
+
+	var d diag.Diagnostics
+	data.Rows, d = flattenQueryRows(ctx, columns, rows)
+	diags.Append(d...)
+
+	return diags
+}
+
+// findQueryResultsByID returns the column metadata and data rows of a successful query execution.
+// The header row returned for DML statements is skipped. If maxRows is positive, at most maxRows rows are returned.
+func findQueryResultsByID(ctx context.Context, conn *athena.Client, id string, statementType awstypes.StatementType, maxRows int) ([]awstypes.ColumnInfo, []awstypes.Row, error) {
+	input := athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(id),
+	}
+	var columns []awstypes.ColumnInfo
+	var rows []awstypes.Row
+	skipHeader := statementType == awstypes.StatementTypeDml
+
+	pages := athena.NewGetQueryResultsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if page.ResultSet == nil {
+			continue
+		}
+
+		if columns == nil && page.ResultSet.ResultSetMetadata != nil {
+			columns = page.ResultSet.ResultSetMetadata.ColumnInfo
+		}
+
+		pageRows := page.ResultSet.Rows
+		if skipHeader && len(pageRows) > 0 {
+			pageRows = pageRows[1:]
+			skipHeader = false
+		}
+
+		rows = append(rows, pageRows...)
+
+		if maxRows > 0 && len(rows) >= maxRows {
+			return columns, rows[:maxRows], nil
+		}
+	}
+
+	return columns, rows, nil
+}
+
+func flattenQueryColumns(ctx context.Context, apiObjects []awstypes.ColumnInfo) fwtypes.ListNestedObjectValueOf[queryColumnModel] {
+	tfList := make([]queryColumnModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, queryColumnModel{
+			Name: fwflex.StringToFramework(ctx, apiObject.Name),
+			Type: fwflex.StringToFramework(ctx, apiObject.Type),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tfList)
+}
+
+// flattenQueryRows converts result rows to maps keyed by column name. SQL NULL values are null map elements.
+func flattenQueryRows(ctx context.Context, columns []awstypes.ColumnInfo, rows []awstypes.Row) (types.List, diag.Diagnostics) {
+	tfList := make([]map[string]*string, 0, len(rows))
+
+	for _, row := range rows {
+		tfMap := make(map[string]*string, len(columns))
+
+		for i, column := range columns {
+			var value *string
+			if i < len(row.Data) {
+				value = row.Data[i].VarCharValue
+			}
+			tfMap[aws.ToString(column.Name)] = value
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return types.ListValueFrom(ctx, queryRowsElemType, tfList)
+}
+
+func findQueryExecutionByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryExecution, error) {
+	input := athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryExecution(ctx, &input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "was not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.QueryExecution, nil
+}
+
+func statusQueryExecution(ctx context.Context, conn *athena.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueryExecutionByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status.State), nil
+	}
+}
+
+func waitQueryExecutionSucceeded(ctx context.Context, conn *athena.Client, id string, timeout time.Duration) (*awstypes.QueryExecution, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueryExecutionStateQueued, awstypes.QueryExecutionStateRunning),
+		Target:     enum.Slice(awstypes.QueryExecutionStateSucceeded),
+		Refresh:    statusQueryExecution(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.QueryExecution); ok {
+		if reason := aws.ToString(output.Status.StateChangeReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
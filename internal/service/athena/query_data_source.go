@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isometry/terraform-provider-faws/internal/framework"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+// @FrameworkDataSource("aws_athena_query", name="Query")
+func newQueryDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &queryDataSource{}, nil
+}
+
+type queryDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+type queryDataSourceModel struct {
+	queryModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *queryDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_athena_query"
+}
+
+func (d *queryDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Optional: true,
+			},
+			"columns": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[queryColumnModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[queryColumnModel](ctx),
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Optional: true,
+			},
+			"execution_parameters": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"max_rows": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"output_location": schema.StringAttribute{
+				Optional: true,
+			},
+			"query_execution_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query_string": schema.StringAttribute{
+				Required: true,
+			},
+			"rows": schema.ListAttribute{
+				ElementType: queryRowsElemType,
+				Computed:    true,
+			},
+			"workgroup": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *queryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data queryDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, queryDefaultTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AthenaClient(ctx)
+
+	response.Diagnostics.Append(runQuery(ctx, conn, &data.queryModel, timeout)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+func TestAccAthenaQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_athena_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "columns.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.name", "n"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.type", "integer"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.name", "letter"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.type", "varchar"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.name", "missing"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_execution_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.n", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.letter", "a"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "rows.0.missing"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1.n", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1.letter", "b"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "workgroup", "aws_athena_workgroup.test", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaQueryDataSource_maxRows(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_athena_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_maxRows(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "1500"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1499.n", "1500"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaQueryDataSource_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueryDataSourceConfig_failed(rName),
+				ExpectError: regexache.MustCompile(`unexpected state 'FAILED'.*(?i)does not exist`),
+			},
+		},
+	})
+}
+
+func testAccQueryConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/output/"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccQueryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccQueryConfig_base(rName), `
+data "aws_athena_query" "test" {
+  workgroup    = aws_athena_workgroup.test.name
+  query_string = "SELECT n, letter, CAST(NULL AS varchar) AS missing FROM (VALUES (1, 'a'), (2, 'b')) AS t (n, letter) ORDER BY n"
+}
+`)
+}
+
+func testAccQueryDataSourceConfig_maxRows(rName string) string {
+	return acctest.ConfigCompose(testAccQueryConfig_base(rName), `
+data "aws_athena_query" "test" {
+  workgroup    = aws_athena_workgroup.test.name
+  query_string = "SELECT n FROM UNNEST(sequence(1, 5000)) AS t (n) ORDER BY n"
+  max_rows     = 1500
+}
+`)
+}
+
+func testAccQueryDataSourceConfig_failed(rName string) string {
+	return acctest.ConfigCompose(testAccQueryConfig_base(rName), `
+data "aws_athena_query" "test" {
+  workgroup    = aws_athena_workgroup.test.name
+  query_string = "SELECT * FROM tf_acc_test_no_such_database.no_such_table"
+}
+`)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFlattenQueryRows(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	columns := []awstypes.ColumnInfo{
+		{Name: aws.String("year"), Type: aws.String("integer")},
+		{Name: aws.String("region"), Type: aws.String("varchar")},
+	}
+	rows := []awstypes.Row{
+		{Data: []awstypes.Datum{{VarCharValue: aws.String("2024")}, {VarCharValue: aws.String("eu-west-1")}}}, //lintignore:AWSAT003
+		{Data: []awstypes.Datum{{VarCharValue: aws.String("2025")}, {}}},
+	}
+
+	tfList, diags := flattenQueryRows(ctx, columns, rows)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var got []map[string]*string
+	if diags := tfList.ElementsAs(ctx, &got, false); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := []map[string]*string{
+		{"year": aws.String("2024"), "region": aws.String("eu-west-1")}, //lintignore:AWSAT003
+		{"year": aws.String("2025"), "region": nil},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
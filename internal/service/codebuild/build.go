@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codebuild
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
+	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+// @SDKResource("aws_codebuild_build", name="Build")
+func resourceBuild() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBuildCreate,
+		ReadWithoutTimeout:   resourceBuildRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"build_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"buildspec_override": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"environment_variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          types.EnvironmentVariableTypePlaintext,
+							ValidateDiagFunc: enum.Validate[types.EnvironmentVariableType](),
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"logs_deep_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceBuildCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
+
+	projectName := d.Get("project_name").(string)
+	input := &codebuild.StartBuildInput{
+		ProjectName: aws.String(projectName),
+	}
+
+	if v, ok := d.GetOk("buildspec_override"); ok {
+		input.BuildspecOverride = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("environment_variable"); ok && len(v.([]interface{})) > 0 {
+		input.EnvironmentVariablesOverride = expandBuildEnvironmentVariables(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_version"); ok {
+		input.SourceVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.StartBuild(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting CodeBuild Project (%s) build: %s", projectName, err)
+	}
+
+	d.SetId(aws.ToString(output.Build.Id))
+
+	build, err := waitBuildComplete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if build != nil {
+		resourceBuildFlatten(d, build)
+	}
+
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || tfresource.TimedOut(err) {
+			log.Printf("[DEBUG] Stopping CodeBuild Build (%s)", d.Id())
+			stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 1*time.Minute)
+			defer cancel()
+			if _, err := conn.StopBuild(stopCtx, &codebuild.StopBuildInput{Id: aws.String(d.Id())}); err != nil {
+				log.Printf("[WARN] Stopping CodeBuild Build (%s): %s", d.Id(), err)
+			}
+		}
+
+		return sdkdiag.AppendErrorf(diags, "waiting for CodeBuild Build (%s) to complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBuildRead(ctx, d, meta)...)
+}
+
+func resourceBuildRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
+
+	build, err := findBuildByID(ctx, conn, d.Id())
+
+	// Builds are retained for a limited time. The resource represents a completed run, so it is kept in state.
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeBuild Build (%s) not found, keeping in state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeBuild Build (%s): %s", d.Id(), err)
+	}
+
+	resourceBuildFlatten(d, build)
+
+	return diags
+}
+
+func resourceBuildFlatten(d *schema.ResourceData, build *types.Build) {
+	d.Set(names.AttrARN, build.Arn)
+	d.Set("build_number", build.BuildNumber)
+	d.Set("build_status", build.BuildStatus)
+	if build.Logs != nil {
+		d.Set("logs_deep_link", build.Logs.DeepLink)
+	}
+	d.Set("project_name", build.ProjectName)
+}
+
+func findBuildByID(ctx context.Context, conn *codebuild.Client, id string) (*types.Build, error) {
+	input := &codebuild.BatchGetBuildsInput{
+		Ids: []string{id},
+	}
+
+	output, err := conn.BatchGetBuilds(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Builds)
+}
+
+func statusBuild(ctx context.Context, conn *codebuild.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBuildByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitBuildComplete(ctx context.Context, conn *codebuild.Client, id string, timeout time.Duration) (*types.Build, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.StatusTypeInProgress),
+		Target:     enum.Slice(types.StatusTypeSucceeded),
+		Refresh:    statusBuild(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Build); ok {
+		tfresource.SetLastError(err, buildError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// buildError returns an error describing the final phase of an unsuccessful build and where to find its logs.
+func buildError(build *types.Build) error {
+	if build.BuildStatus == types.StatusTypeSucceeded || build.BuildStatus == types.StatusTypeInProgress {
+		return nil
+	}
+
+	var parts []string
+
+	for _, phase := range build.Phases {
+		if phase.PhaseStatus == "" || phase.PhaseStatus == types.StatusTypeSucceeded {
+			continue
+		}
+
+		part := fmt.Sprintf("phase %s %s", phase.PhaseType, phase.PhaseStatus)
+		for _, v := range phase.Contexts {
+			if message := aws.ToString(v.Message); message != "" {
+				part += ": " + message
+			}
+		}
+		parts = append(parts, part)
+	}
+
+	if build.Logs != nil {
+		if v := aws.ToString(build.Logs.DeepLink); v != "" {
+			parts = append(parts, "logs: "+v)
+		}
+	}
+
+	if len(parts) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(parts, "; "))
+}
+
+func expandBuildEnvironmentVariables(tfList []interface{}) []types.EnvironmentVariable {
+	apiObjects := make([]types.EnvironmentVariable, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.EnvironmentVariable{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Type:  types.EnvironmentVariableType(tfMap[names.AttrType].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
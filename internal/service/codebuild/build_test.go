@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codebuild_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	tfcodebuild "github.com/isometry/terraform-provider-faws/internal/service/codebuild"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+func TestAccCodeBuildBuild_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var build types.Build
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_build.test"
+	projectResourceName := "aws_codebuild_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBuildExists(ctx, resourceName, &build),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "build_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "build_status", string(types.StatusTypeSucceeded)),
+					resource.TestCheckResourceAttrSet(resourceName, "logs_deep_link"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_name", projectResourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeBuildBuild_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var build1, build2 types.Build
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_build.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildConfig_triggers(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBuildExists(ctx, resourceName, &build1),
+					resource.TestCheckResourceAttr(resourceName, "build_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config:   testAccBuildConfig_triggers(rName, "1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccBuildConfig_triggers(rName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBuildExists(ctx, resourceName, &build2),
+					testAccCheckBuildRecreated(&build1, &build2),
+					resource.TestCheckResourceAttr(resourceName, "build_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeBuildBuild_environmentVariable(t *testing.T) {
+	ctx := acctest.Context(t)
+	var build types.Build
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_build.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBuildConfig_environmentVariable(rName, "expected"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBuildExists(ctx, resourceName, &build),
+					resource.TestCheckResourceAttr(resourceName, "build_status", string(types.StatusTypeSucceeded)),
+					resource.TestCheckResourceAttr(resourceName, "environment_variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variable.0.name", "EXPECTED"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variable.0.type", string(types.EnvironmentVariableTypePlaintext)),
+					resource.TestCheckResourceAttr(resourceName, "environment_variable.0.value", "expected"),
+				),
+			},
+			{
+				Config:      testAccBuildConfig_environmentVariable(rName, "unexpected"),
+				ExpectError: regexp.MustCompile(`phase BUILD FAILED`),
+			},
+		},
+	})
+}
+
+func testAccCheckBuildExists(ctx context.Context, n string, v *types.Build) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildClient(ctx)
+
+		output, err := tfcodebuild.FindBuildByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckBuildRecreated(before, after *types.Build) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before == after {
+			return fmt.Errorf("CodeBuild Build (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccBuildConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_baseServiceRole(rName), fmt.Sprintf(`
+resource "aws_codebuild_project" "test" {
+  name         = %[1]q
+  service_role = aws_iam_role.test.arn
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "aws/codebuild/amazonlinux2-x86_64-standard:5.0"
+    type         = "LINUX_CONTAINER"
+
+    environment_variable {
+      name  = "EXPECTED"
+      value = "expected"
+    }
+  }
+
+  source {
+    type      = "NO_SOURCE"
+    buildspec = <<EOF
+version: 0.2
+phases:
+  build:
+    commands:
+      - test "$EXPECTED" = "expected"
+EOF
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccBuildConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBuildConfig_base(rName), `
+resource "aws_codebuild_build" "test" {
+  project_name = aws_codebuild_project.test.name
+}
+`)
+}
+
+func testAccBuildConfig_triggers(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccBuildConfig_base(rName), fmt.Sprintf(`
+resource "aws_codebuild_build" "test" {
+  project_name = aws_codebuild_project.test.name
+
+  triggers = {
+    redeployment = %[1]q
+  }
+}
+`, trigger))
+}
+
+func testAccBuildConfig_environmentVariable(rName, value string) string {
+	return acctest.ConfigCompose(testAccBuildConfig_base(rName), fmt.Sprintf(`
+resource "aws_codebuild_build" "test" {
+  project_name = aws_codebuild_project.test.name
+
+  environment_variable {
+    name  = "EXPECTED"
+    value = %[1]q
+  }
+}
+`, value))
+}
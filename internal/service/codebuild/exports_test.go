@@ -5,6 +5,7 @@ package codebuild
 
 // Exports for use in tests only.
 var (
+	ResourceBuild            = resourceBuild
 	ResourceFleet            = resourceFleet
 	ResourceProject          = resourceProject
 	ResourceReportGroup      = resourceReportGroup
@@ -12,6 +13,7 @@ var (
 	ResourceSourceCredential = resourceSourceCredential
 	ResourceWebhook          = resourceWebhook
 
+	FindBuildByID              = findBuildByID
 	FindFleetByARN             = findFleetByARN
 	FindProjectByNameOrARN     = findProjectByNameOrARN
 	FindReportGroupByARN       = findReportGroupByARN
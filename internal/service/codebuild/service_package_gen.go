@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBuild,
+			TypeName: "aws_codebuild_build",
+			Name:     "Build",
+		},
 		{
 			Factory:  resourceFleet,
 			TypeName: "aws_codebuild_fleet",
@@ -11,6 +11,7 @@ var (
 	ResourceTable                       = resourceTable
 	ResourceTableExport                 = resourceTableExport
 	ResourceTableItem                   = resourceTableItem
+	ResourceTableItems                  = resourceTableItems
 	ResourceTableReplica                = resourceTableReplica
 	ResourceTag                         = resourceTag
 	ResourceResourcePolicy              = newResourcePolicyResource
@@ -19,6 +20,8 @@ var (
 	ContributorInsightsParseResourceID           = contributorInsightsParseResourceID
 	ExpandTableItemAttributes                    = expandTableItemAttributes
 	ExpandTableItemQueryKey                      = expandTableItemQueryKey
+	ExpandTableItemsCSV                          = expandTableItemsCSV
+	ExpandTableItemsJSONL                        = expandTableItemsJSONL
 	FindContributorInsightsByTwoPartKey          = findContributorInsightsByTwoPartKey
 	FindGlobalTableByName                        = findGlobalTableByName
 	FindKinesisDataStreamDestinationByTwoPartKey = findKinesisDataStreamDestinationByTwoPartKey
@@ -32,6 +35,8 @@ var (
 	RegionFromARN                                = regionFromARN
 	ReplicaForRegion                             = replicaForRegion
 	TableNameFromARN                             = tableNameFromARN
+	TableItemHash                                = tableItemHash
+	TableItemKey                                 = tableItemKey
 	TableReplicaParseResourceID                  = tableReplicaParseResourceID
 	UpdateDiffGSI                                = updateDiffGSI
 )
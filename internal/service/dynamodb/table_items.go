@@ -0,0 +1,881 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"math/big"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
+	tfjson "github.com/isometry/terraform-provider-faws/internal/json"
+	tfmaps "github.com/isometry/terraform-provider-faws/internal/maps"
+	tfretry "github.com/isometry/terraform-provider-faws/internal/retry"
+	tfslices "github.com/isometry/terraform-provider-faws/internal/slices"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	itypes "github.com/isometry/terraform-provider-faws/internal/types"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	batchGetItemMaxKeys    = 100
+	batchWriteItemMaxItems = 25
+)
+
+type tableItemsSourceFormat string
+
+const (
+	tableItemsSourceFormatCSV   tableItemsSourceFormat = "CSV"
+	tableItemsSourceFormatJSONL tableItemsSourceFormat = "JSONL"
+)
+
+func (tableItemsSourceFormat) Values() []tableItemsSourceFormat {
+	return []tableItemsSourceFormat{
+		tableItemsSourceFormatCSV,
+		tableItemsSourceFormatJSONL,
+	}
+}
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"content_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"item_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"items": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", names.AttrSource},
+				ValidateFunc: validateTableItems,
+				// Only a digest of the items is stored in state.
+				StateFunc: func(v interface{}) string {
+					return tableItemsHashSum(v.(string))
+				},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrSource: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"items", names.AttrSource},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrFormat: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[tableItemsSourceFormat](),
+						},
+						names.AttrPath: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type_mapping": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									dataTypeDescriptorBinary,
+									dataTypeDescriptorBinarySet,
+									dataTypeDescriptorBoolean,
+									dataTypeDescriptorList,
+									dataTypeDescriptorMap,
+									dataTypeDescriptorNull,
+									dataTypeDescriptorNumber,
+									dataTypeDescriptorNumberSet,
+									dataTypeDescriptorString,
+									dataTypeDescriptorStringSet,
+								}, false),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func validateTableItems(v interface{}, k string) (ws []string, errors []error) {
+	_, err := expandTableItemsAttributes(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Invalid format of %q: %s", k, err))
+	}
+	return
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	items, err := tableItemsFromConfig(d.GetRawConfig(), d.Get("hash_key").(string), d.Get("range_key").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	requests := make([]awstypes.WriteRequest, 0, len(items))
+	for _, v := range items {
+		requests = append(requests, awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{
+				Item: v.attributes,
+			},
+		})
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+	d.Set("item_hashes", tableItemsHashes(items))
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	hashKey, rangeKey := d.Get("hash_key").(string), d.Get("range_key").(string)
+	managed := flattenTableItemsHashes(d.Get("item_hashes").(map[string]interface{}))
+
+	keys, err := expandTableItemsKeys(managed)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	// Only the items whose keys are recorded in state are read.
+	itemHashes := make(map[string]string, len(managed))
+	err = batchGetTableItems(ctx, conn, d.Id(), keys, d.Timeout(schema.TimeoutRead), func(item map[string]awstypes.AttributeValue) error {
+		key, err := tableItemKey(item, hashKey, rangeKey)
+		if err != nil {
+			return err
+		}
+
+		if itemHashes[key], err = tableItemHash(item); err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Items (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	d.Set("content_digest", tableItemsContentDigest(itemHashes))
+	d.Set("item_hashes", itemHashes)
+	d.Set(names.AttrTableName, d.Id())
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	hashKey, rangeKey := d.Get("hash_key").(string), d.Get("range_key").(string)
+	items, err := tableItemsFromConfig(d.GetRawConfig(), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	o, _ := d.GetChange("item_hashes")
+	old := flattenTableItemsHashes(o.(map[string]interface{}))
+	desired := tableItemsHashes(items)
+
+	var requests []awstypes.WriteRequest
+	for _, v := range items {
+		if old[v.key] != v.hash {
+			requests = append(requests, awstypes.WriteRequest{
+				PutRequest: &awstypes.PutRequest{
+					Item: v.attributes,
+				},
+			})
+		}
+	}
+
+	removed := make(map[string]string)
+	for k, v := range old {
+		if _, ok := desired[k]; !ok {
+			removed[k] = v
+		}
+	}
+
+	keys, err := expandTableItemsKeys(removed)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	for _, v := range keys {
+		requests = append(requests, awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: v,
+			},
+		})
+	}
+
+	if err := batchWriteTableItems(ctx, conn, d.Id(), requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	d.Set("item_hashes", desired)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	managed := flattenTableItemsHashes(d.Get("item_hashes").(map[string]interface{}))
+	if len(managed) == 0 {
+		return diags
+	}
+
+	keys, err := expandTableItemsKeys(managed)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	requests := tfslices.ApplyToAll(keys, func(v map[string]awstypes.AttributeValue) awstypes.WriteRequest {
+		return awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: v,
+			},
+		}
+	})
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Items: %s", d.Id())
+	err = batchWriteTableItems(ctx, conn, d.Id(), requests, d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTableItemsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("hash_key") || !d.NewValueKnown("range_key") {
+		return tableItemsSetNewComputed(d)
+	}
+
+	config := d.GetRawConfig()
+	if !config.GetAttr("items").IsWhollyKnown() || !config.GetAttr(names.AttrSource).IsWhollyKnown() {
+		return tableItemsSetNewComputed(d)
+	}
+
+	items, err := tableItemsFromConfig(config, d.Get("hash_key").(string), d.Get("range_key").(string))
+	if err != nil {
+		return err
+	}
+
+	if digest := tableItemsContentDigest(tableItemsHashes(items)); d.Id() == "" || digest != d.Get("content_digest").(string) {
+		if err := d.SetNew("content_digest", digest); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("item_hashes")
+	}
+
+	return nil
+}
+
+func tableItemsSetNewComputed(d *schema.ResourceDiff) error {
+	if err := d.SetNewComputed("content_digest"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("item_hashes")
+}
+
+// tableItem is an item to be written, with its primary key and content hash.
+type tableItem struct {
+	attributes map[string]awstypes.AttributeValue
+	hash       string
+	key        string
+}
+
+// tableItemsFromConfig returns the items from either the items argument or the source file.
+// Items are validated to have the table's key attributes and unique keys.
+func tableItemsFromConfig(config cty.Value, hashKey, rangeKey string) ([]tableItem, error) {
+	var attributes []map[string]awstypes.AttributeValue
+	var err error
+
+	if v := config.GetAttr("items"); !v.IsNull() {
+		attributes, err = expandTableItemsAttributes(v.AsString())
+	} else if v := config.GetAttr(names.AttrSource); !v.IsNull() && v.LengthInt() > 0 {
+		attributes, err = expandTableItemsSource(v.Index(cty.NumberIntVal(0)))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]tableItem, 0, len(attributes))
+	seen := make(map[string]int, len(attributes))
+
+	for i, v := range attributes {
+		key, err := tableItemKey(v, hashKey, rangeKey)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+
+		if j, ok := seen[key]; ok {
+			return nil, fmt.Errorf("item %d: duplicate key of item %d", i, j)
+		}
+		seen[key] = i
+
+		hash, err := tableItemHash(v)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+
+		items = append(items, tableItem{
+			attributes: v,
+			hash:       hash,
+			key:        key,
+		})
+	}
+
+	return items, nil
+}
+
+// expandTableItemsAttributes expands a JSON array of items in DynamoDB JSON format.
+func expandTableItemsAttributes(jsonStream string) ([]map[string]awstypes.AttributeValue, error) {
+	var l []map[string]any
+
+	err := tfjson.DecodeFromString(jsonStream, &l)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAllWithError(l, func(m map[string]any) (map[string]awstypes.AttributeValue, error) {
+		return tfmaps.ApplyToAllValuesWithError(m, attributeFromRaw)
+	})
+}
+
+func expandTableItemsSource(source cty.Value) ([]map[string]awstypes.AttributeValue, error) {
+	path := source.GetAttr(names.AttrPath).AsString()
+	typeMapping := make(map[string]string)
+	if v := source.GetAttr("type_mapping"); !v.IsNull() {
+		for k, v := range v.AsValueMap() {
+			typeMapping[k] = v.AsString()
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var items []map[string]awstypes.AttributeValue
+
+	switch format := tableItemsSourceFormat(source.GetAttr(names.AttrFormat).AsString()); format {
+	case tableItemsSourceFormatCSV:
+		items, err = expandTableItemsCSV(f, typeMapping)
+	case tableItemsSourceFormatJSONL:
+		items, err = expandTableItemsJSONL(f, typeMapping)
+	default:
+		err = fmt.Errorf("unsupported format: %s", format)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return items, nil
+}
+
+// expandTableItemsCSV expands CSV records to items. The first record names the attributes.
+// Values are strings unless mapped to another type, and empty values are omitted.
+func expandTableItemsCSV(r io.Reader, typeMapping map[string]string) ([]map[string]awstypes.AttributeValue, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var items []map[string]awstypes.AttributeValue
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		item := make(map[string]awstypes.AttributeValue, len(record))
+		for i, v := range record {
+			if v == "" {
+				continue
+			}
+
+			name := header[i]
+			descriptor, ok := typeMapping[name]
+			if !ok {
+				descriptor = dataTypeDescriptorString
+			}
+
+			if item[name], err = attributeFromPlain(v, descriptor); err != nil {
+				return nil, fmt.Errorf("line %d: attribute %q: %w", line, name, err)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// expandTableItemsJSONL expands JSON Lines objects to items.
+// Value types are inferred unless mapped to a DynamoDB data type.
+func expandTableItemsJSONL(r io.Reader, typeMapping map[string]string) ([]map[string]awstypes.AttributeValue, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 4*1024*1024)
+
+	var items []map[string]awstypes.AttributeValue
+
+	for line := 1; scanner.Scan(); line++ {
+		b := scanner.Bytes()
+		if len(strings.TrimSpace(string(b))) == 0 {
+			continue
+		}
+
+		var m map[string]any
+		decoder := json.NewDecoder(strings.NewReader(string(b)))
+		decoder.UseNumber()
+		if err := decoder.Decode(&m); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		item := make(map[string]awstypes.AttributeValue, len(m))
+		for name, v := range m {
+			var err error
+			if item[name], err = attributeFromPlain(v, typeMapping[name]); err != nil {
+				return nil, fmt.Errorf("line %d: attribute %q: %w", line, name, err)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// attributeFromPlain converts a plain JSON or CSV value to an attribute value of the specified DynamoDB data type.
+// If no data type is specified, it is inferred from the value.
+func attributeFromPlain(v any, descriptor string) (awstypes.AttributeValue, error) {
+	switch descriptor {
+	case "":
+		switch v := v.(type) {
+		case nil:
+			return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+		case bool:
+			return &awstypes.AttributeValueMemberBOOL{Value: v}, nil
+		case json.Number:
+			return &awstypes.AttributeValueMemberN{Value: v.String()}, nil
+		case string:
+			return &awstypes.AttributeValueMemberS{Value: v}, nil
+		case []any:
+			return attributeFromPlain(v, dataTypeDescriptorList)
+		case map[string]any:
+			return attributeFromPlain(v, dataTypeDescriptorMap)
+		}
+	case dataTypeDescriptorBinary:
+		if v, ok := v.(string); ok {
+			b, err := itypes.Base64Decode(v)
+			if err != nil {
+				return nil, err
+			}
+			return &awstypes.AttributeValueMemberB{Value: b}, nil
+		}
+	case dataTypeDescriptorBoolean:
+		switch v := v.(type) {
+		case bool:
+			return &awstypes.AttributeValueMemberBOOL{Value: v}, nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, err
+			}
+			return &awstypes.AttributeValueMemberBOOL{Value: b}, nil
+		}
+	case dataTypeDescriptorNull:
+		return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+	case dataTypeDescriptorNumber:
+		var s string
+		switch v := v.(type) {
+		case json.Number:
+			s = v.String()
+		case string:
+			s = v
+		default:
+			return nil, unexpectedPlainAttributeTypeError(v, descriptor)
+		}
+		if _, ok := new(big.Float).SetString(s); !ok {
+			return nil, fmt.Errorf("invalid number: %q", s)
+		}
+		return &awstypes.AttributeValueMemberN{Value: s}, nil
+	case dataTypeDescriptorString:
+		switch v := v.(type) {
+		case json.Number:
+			return &awstypes.AttributeValueMemberS{Value: v.String()}, nil
+		case string:
+			return &awstypes.AttributeValueMemberS{Value: v}, nil
+		}
+	case dataTypeDescriptorBinarySet, dataTypeDescriptorList, dataTypeDescriptorMap, dataTypeDescriptorNumberSet, dataTypeDescriptorStringSet:
+		// Composite values in CSV files are JSON encoded.
+		if s, ok := v.(string); ok {
+			decoder := json.NewDecoder(strings.NewReader(s))
+			decoder.UseNumber()
+			if err := decoder.Decode(&v); err != nil {
+				return nil, err
+			}
+		}
+
+		switch descriptor {
+		case dataTypeDescriptorMap:
+			if v, ok := v.(map[string]any); ok {
+				v, err := tfmaps.ApplyToAllValuesWithError(v, func(v any) (awstypes.AttributeValue, error) {
+					return attributeFromPlain(v, "")
+				})
+				if err != nil {
+					return nil, err
+				}
+				return &awstypes.AttributeValueMemberM{Value: v}, nil
+			}
+		default:
+			l, ok := v.([]any)
+			if !ok {
+				break
+			}
+
+			element := map[string]string{
+				dataTypeDescriptorBinarySet: dataTypeDescriptorBinary,
+				dataTypeDescriptorNumberSet: dataTypeDescriptorNumber,
+				dataTypeDescriptorStringSet: dataTypeDescriptorString,
+			}[descriptor]
+			elements, err := tfslices.ApplyToAllWithError(l, func(v any) (awstypes.AttributeValue, error) {
+				return attributeFromPlain(v, element)
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			switch descriptor {
+			case dataTypeDescriptorBinarySet:
+				return &awstypes.AttributeValueMemberBS{Value: tfslices.ApplyToAll(elements, func(v awstypes.AttributeValue) []byte {
+					return v.(*awstypes.AttributeValueMemberB).Value
+				})}, nil
+			case dataTypeDescriptorNumberSet:
+				return &awstypes.AttributeValueMemberNS{Value: tfslices.ApplyToAll(elements, func(v awstypes.AttributeValue) string {
+					return v.(*awstypes.AttributeValueMemberN).Value
+				})}, nil
+			case dataTypeDescriptorStringSet:
+				return &awstypes.AttributeValueMemberSS{Value: tfslices.ApplyToAll(elements, func(v awstypes.AttributeValue) string {
+					return v.(*awstypes.AttributeValueMemberS).Value
+				})}, nil
+			default:
+				return &awstypes.AttributeValueMemberL{Value: elements}, nil
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported data type descriptor: %s", descriptor)
+	}
+
+	return nil, unexpectedPlainAttributeTypeError(v, descriptor)
+}
+
+func unexpectedPlainAttributeTypeError(v any, descriptor string) error {
+	return fmt.Errorf("unexpected value type (%T) for data type descriptor: %s", v, descriptor)
+}
+
+// tableItemKey returns an item's primary key in DynamoDB JSON. Numbers are normalized.
+func tableItemKey(item map[string]awstypes.AttributeValue, hashKey, rangeKey string) (string, error) {
+	names := []string{hashKey}
+	if rangeKey != "" {
+		names = append(names, rangeKey)
+	}
+
+	key := make(map[string]awstypes.AttributeValue, len(names))
+	for _, name := range names {
+		switch v := item[name].(type) {
+		case *awstypes.AttributeValueMemberB, *awstypes.AttributeValueMemberS:
+			key[name] = v
+		case *awstypes.AttributeValueMemberN:
+			key[name] = &awstypes.AttributeValueMemberN{Value: normalizeTableItemNumber(v.Value)}
+		case nil:
+			return "", fmt.Errorf("missing key attribute %q", name)
+		default:
+			return "", fmt.Errorf("key attribute %q must be a string, number or binary", name)
+		}
+	}
+
+	v, err := flattenTableItemAttributes(key)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(v), nil
+}
+
+// expandTableItemsKeys expands the primary keys of the specified item hashes.
+func expandTableItemsKeys(itemHashes map[string]string) ([]map[string]awstypes.AttributeValue, error) {
+	keys := make([]map[string]awstypes.AttributeValue, 0, len(itemHashes))
+	for _, k := range slices.Sorted(maps.Keys(itemHashes)) {
+		key, err := expandTableItemAttributes(k)
+		if err != nil {
+			return nil, fmt.Errorf("expanding item key (%s): %w", k, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// tableItemHash returns a hash of an item's content. Sets and numbers are normalized.
+func tableItemHash(item map[string]awstypes.AttributeValue) (string, error) {
+	v, err := flattenTableItemAttributes(tfmaps.ApplyToAllValues(item, normalizeTableItemAttribute))
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func normalizeTableItemAttribute(a awstypes.AttributeValue) awstypes.AttributeValue {
+	switch a := a.(type) {
+	case *awstypes.AttributeValueMemberBS:
+		v := slices.Clone(a.Value)
+		slices.SortFunc(v, bytes.Compare)
+		return &awstypes.AttributeValueMemberBS{Value: v}
+	case *awstypes.AttributeValueMemberL:
+		return &awstypes.AttributeValueMemberL{Value: tfslices.ApplyToAll(a.Value, normalizeTableItemAttribute)}
+	case *awstypes.AttributeValueMemberM:
+		return &awstypes.AttributeValueMemberM{Value: tfmaps.ApplyToAllValues(a.Value, normalizeTableItemAttribute)}
+	case *awstypes.AttributeValueMemberN:
+		return &awstypes.AttributeValueMemberN{Value: normalizeTableItemNumber(a.Value)}
+	case *awstypes.AttributeValueMemberNS:
+		v := tfslices.ApplyToAll(a.Value, normalizeTableItemNumber)
+		slices.Sort(v)
+		return &awstypes.AttributeValueMemberNS{Value: v}
+	case *awstypes.AttributeValueMemberSS:
+		v := slices.Clone(a.Value)
+		slices.Sort(v)
+		return &awstypes.AttributeValueMemberSS{Value: v}
+	default:
+		return a
+	}
+}
+
+// normalizeTableItemNumber returns the representation of a number that DynamoDB returns, e.g. "1.50" is returned as "1.5".
+func normalizeTableItemNumber(s string) string {
+	// DynamoDB numbers have up to 38 digits of precision.
+	f, ok := new(big.Float).SetPrec(256).SetString(s)
+	if !ok {
+		return s
+	}
+
+	return f.Text('f', -1)
+}
+
+func tableItemsHashes(items []tableItem) map[string]string {
+	m := make(map[string]string, len(items))
+	for _, v := range items {
+		m[v.key] = v.hash
+	}
+
+	return m
+}
+
+func flattenTableItemsHashes(tfMap map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(tfMap))
+	for k, v := range tfMap {
+		m[k] = v.(string)
+	}
+
+	return m
+}
+
+// tableItemsContentDigest returns a digest of the content of all items.
+func tableItemsContentDigest(itemHashes map[string]string) string {
+	hash := sha256.New()
+	for _, k := range slices.Sorted(maps.Keys(itemHashes)) {
+		hash.Write([]byte(k + ":" + itemHashes[k] + "\n"))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func tableItemsHashSum(v string) string {
+	hash := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(hash[:])
+}
+
+// batchGetTableItems calls f for each of the specified items that exists, reading them in batches and retrying unprocessed keys with exponential backoff.
+func batchGetTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue, timeout time.Duration, f func(map[string]awstypes.AttributeValue) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for chunk := range slices.Chunk(keys, batchGetItemMaxKeys) {
+		unprocessed := chunk
+
+		for r := tfretry.BeginWithOptions(tfretry.Options{BackoffMinDuration: 100 * time.Millisecond, BackoffMultiplier: 1.5}); len(unprocessed) > 0 && r.Continue(ctx); {
+			input := &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]awstypes.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           unprocessed,
+					},
+				},
+			}
+
+			output, err := conn.BatchGetItem(ctx, input)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				}
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range output.Responses[tableName] {
+				if err := f(v); err != nil {
+					return err
+				}
+			}
+
+			unprocessed = output.UnprocessedKeys[tableName].Keys
+		}
+
+		if n := len(unprocessed); n > 0 {
+			return fmt.Errorf("%d unprocessed keys: %w", n, ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+// batchWriteTableItems writes the requests in batches, retrying unprocessed items with exponential backoff.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for chunk := range slices.Chunk(requests, batchWriteItemMaxItems) {
+		unprocessed := chunk
+
+		for r := tfretry.BeginWithOptions(tfretry.Options{BackoffMinDuration: 100 * time.Millisecond, BackoffMultiplier: 1.5}); len(unprocessed) > 0 && r.Continue(ctx); {
+			input := &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]awstypes.WriteRequest{
+					tableName: unprocessed,
+				},
+			}
+
+			output, err := conn.BatchWriteItem(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			unprocessed = output.UnprocessedItems[tableName]
+		}
+
+		if n := len(unprocessed); n > 0 {
+			return fmt.Errorf("%d unprocessed items: %w", n, ctx.Err())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,635 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	tfdynamodb "github.com/isometry/terraform-provider-faws/internal/service/dynamodb"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+func TestExpandTableItemsCSV(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input       string
+		typeMapping map[string]string
+		expected    []map[string]awstypes.AttributeValue
+		expectedErr string
+	}{
+		"empty": {
+			input: ``,
+		},
+		"strings": {
+			input: "id,name\na,Alpha\nb,\n",
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					names.AttrID:   &awstypes.AttributeValueMemberS{Value: "a"},
+					names.AttrName: &awstypes.AttributeValueMemberS{Value: "Alpha"},
+				},
+				{
+					names.AttrID: &awstypes.AttributeValueMemberS{Value: "b"},
+				},
+			},
+		},
+		"type mapping": {
+			input: "id,count,enabled,tags\n1,10.50,true,\"[\"\"x\"\",\"\"y\"\"]\"\n",
+			typeMapping: map[string]string{
+				names.AttrID:      "N",
+				"count":           "N",
+				names.AttrEnabled: "BOOL",
+				names.AttrTags:    "SS",
+			},
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					names.AttrID:      &awstypes.AttributeValueMemberN{Value: "1"},
+					"count":           &awstypes.AttributeValueMemberN{Value: "10.50"},
+					names.AttrEnabled: &awstypes.AttributeValueMemberBOOL{Value: true},
+					names.AttrTags:    &awstypes.AttributeValueMemberSS{Value: []string{"x", "y"}},
+				},
+			},
+		},
+		"invalid number": {
+			input:       "id\nabc\n",
+			typeMapping: map[string]string{names.AttrID: "N"},
+			expectedErr: `line 2: attribute "id": invalid number: "abc"`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := tfdynamodb.ExpandTableItemsCSV(strings.NewReader(tc.input), tc.typeMapping)
+
+			if tc.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.EqualFunc(actual, tc.expected, func(a, b map[string]awstypes.AttributeValue) bool {
+				return maps.EqualFunc(a, b, attributeValuesEqual)
+			}) {
+				t.Fatalf("expected\n%s\ngot\n%s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestExpandTableItemsJSONL(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input       string
+		typeMapping map[string]string
+		expected    []map[string]awstypes.AttributeValue
+		expectedErr string
+	}{
+		"inferred": {
+			input: `{"id": "a", "count": 12345678901234567890, "enabled": false, "none": null}
+
+{"id": "b", "list": [1, "x"], "map": {"k": true}}
+`,
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					names.AttrID:      &awstypes.AttributeValueMemberS{Value: "a"},
+					"count":           &awstypes.AttributeValueMemberN{Value: "12345678901234567890"},
+					names.AttrEnabled: &awstypes.AttributeValueMemberBOOL{Value: false},
+					"none":            &awstypes.AttributeValueMemberNULL{Value: true},
+				},
+				{
+					names.AttrID: &awstypes.AttributeValueMemberS{Value: "b"},
+					"list": &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{
+						&awstypes.AttributeValueMemberN{Value: "1"},
+						&awstypes.AttributeValueMemberS{Value: "x"},
+					}},
+					"map": &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{
+						"k": &awstypes.AttributeValueMemberBOOL{Value: true},
+					}},
+				},
+			},
+		},
+		"type mapping": {
+			input: `{"id": 1, "data": "YmxvYg==", "scores": [3, 1]}`,
+			typeMapping: map[string]string{
+				names.AttrID: "S",
+				"data":       "B",
+				"scores":     "NS",
+			},
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					names.AttrID: &awstypes.AttributeValueMemberS{Value: "1"},
+					"data":       &awstypes.AttributeValueMemberB{Value: []byte("blob")},
+					"scores":     &awstypes.AttributeValueMemberNS{Value: []string{"3", "1"}},
+				},
+			},
+		},
+		"invalid JSON": {
+			input:       "{\"id\": \"a\"}\n{\"id\":\n",
+			expectedErr: "line 2:",
+		},
+		"type mismatch": {
+			input:       `{"id": true}`,
+			typeMapping: map[string]string{names.AttrID: "S"},
+			expectedErr: `line 1: attribute "id": unexpected value type (bool) for data type descriptor: S`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := tfdynamodb.ExpandTableItemsJSONL(strings.NewReader(tc.input), tc.typeMapping)
+
+			if tc.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("expected error containing %q, got: %v", tc.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.EqualFunc(actual, tc.expected, func(a, b map[string]awstypes.AttributeValue) bool {
+				return maps.EqualFunc(a, b, attributeValuesEqual)
+			}) {
+				t.Fatalf("expected\n%s\ngot\n%s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTableItemHash(t *testing.T) {
+	t.Parallel()
+
+	// Equivalent items as written and as returned by DynamoDB.
+	written := map[string]awstypes.AttributeValue{
+		names.AttrID: &awstypes.AttributeValueMemberS{Value: "a"},
+		"price":      &awstypes.AttributeValueMemberN{Value: "1.50"},
+		"sizes":      &awstypes.AttributeValueMemberNS{Value: []string{"10", "2.0"}},
+		names.AttrTags: &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{
+			"colors": &awstypes.AttributeValueMemberSS{Value: []string{"red", "blue"}},
+		}},
+	}
+	returned := map[string]awstypes.AttributeValue{
+		names.AttrID: &awstypes.AttributeValueMemberS{Value: "a"},
+		"price":      &awstypes.AttributeValueMemberN{Value: "1.5"},
+		"sizes":      &awstypes.AttributeValueMemberNS{Value: []string{"2", "10"}},
+		names.AttrTags: &awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{
+			"colors": &awstypes.AttributeValueMemberSS{Value: []string{"blue", "red"}},
+		}},
+	}
+	changed := map[string]awstypes.AttributeValue{
+		names.AttrID: &awstypes.AttributeValueMemberS{Value: "a"},
+		"price":      &awstypes.AttributeValueMemberN{Value: "1.51"},
+	}
+
+	hash := func(item map[string]awstypes.AttributeValue) string {
+		v, err := tfdynamodb.TableItemHash(item)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return v
+	}
+
+	if a, b := hash(written), hash(returned); a != b {
+		t.Errorf("expected equivalent items to have the same hash, got %s and %s", a, b)
+	}
+
+	if a, b := hash(written), hash(changed); a == b {
+		t.Errorf("expected different items to have different hashes, got %s", a)
+	}
+}
+
+func TestTableItemKey(t *testing.T) {
+	t.Parallel()
+
+	item := map[string]awstypes.AttributeValue{
+		"pk":   &awstypes.AttributeValueMemberS{Value: "a"},
+		"sk":   &awstypes.AttributeValueMemberN{Value: "1.0"},
+		"list": &awstypes.AttributeValueMemberL{},
+	}
+
+	hashKeyOnly, err := tfdynamodb.TableItemKey(item, "pk", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"pk":{"S":"a"}}`; hashKeyOnly != want {
+		t.Errorf("expected key %s, got %s", want, hashKeyOnly)
+	}
+
+	withRangeKey, err := tfdynamodb.TableItemKey(item, "pk", "sk")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"pk":{"S":"a"},"sk":{"N":"1"}}`; withRangeKey != want {
+		t.Errorf("expected key %s, got %s", want, withRangeKey)
+	}
+
+	normalized, err := tfdynamodb.TableItemKey(map[string]awstypes.AttributeValue{
+		"pk": &awstypes.AttributeValueMemberS{Value: "a"},
+		"sk": &awstypes.AttributeValueMemberN{Value: "1"},
+	}, "pk", "sk")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if normalized != withRangeKey {
+		t.Errorf("expected equivalent number keys to be the same")
+	}
+
+	if _, err := tfdynamodb.TableItemKey(item, "missing", ""); err == nil || !strings.Contains(err.Error(), `missing key attribute "missing"`) {
+		t.Errorf("expected missing key attribute error, got: %v", err)
+	}
+
+	if _, err := tfdynamodb.TableItemKey(item, "list", ""); err == nil || !strings.Contains(err.Error(), `key attribute "list" must be a string, number or binary`) {
+		t.Errorf("expected invalid key attribute error, got: %v", err)
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(tableName, testAccTableItemsJSON(30)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 30),
+					testAccCheckTableItemsItemExists(ctx, tableName, "item-29"),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "hashKey"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "content_digest"),
+					resource.TestMatchResourceAttr(resourceName, "items", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, tableName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(tableName, testAccTableItemsJSON(3)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 3),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdynamodb.ResourceTableItems(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	var digest string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(tableName, `[
+  {"hashKey": {"S": "one"}, "value": {"N": "1"}},
+  {"hashKey": {"S": "two"}, "value": {"N": "2"}},
+  {"hashKey": {"S": "three"}, "value": {"N": "3"}}
+]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					testAccCheckTableItemsContentDigest(resourceName, &digest),
+				),
+			},
+			{
+				// Reformatting the items doesn't change their content.
+				Config: testAccTableItemsConfig_basic(tableName, `[{"hashKey": {"S": "one"}, "value": {"N": "1.0"}}, {"hashKey": {"S": "two"}, "value": {"N": "2"}}, {"hashKey": {"S": "three"}, "value": {"N": "3"}}]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 3),
+					resource.TestCheckResourceAttrPtr(resourceName, "content_digest", &digest),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(tableName, `[
+  {"hashKey": {"S": "one"}, "value": {"N": "1"}},
+  {"hashKey": {"S": "two"}, "value": {"N": "22"}, "extra": {"SS": ["a", "b"]}},
+  {"hashKey": {"S": "four"}, "value": {"N": "4"}}
+]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 3),
+					testAccCheckTableItemsItemExists(ctx, tableName, "four"),
+					testAccCheckTableItemsItemNotExists(ctx, tableName, "three"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_rangeKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(tableName, `[
+  {"hashKey": {"S": "a"}, "rangeKey": {"N": "1"}},
+  {"hashKey": {"S": "a"}, "rangeKey": {"N": "2"}},
+  {"hashKey": {"S": "b"}, "rangeKey": {"N": "1"}}
+]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 3),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "rangeKey"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_rangeKey(tableName, `[
+  {"hashKey": {"S": "a"}, "rangeKey": {"N": "1"}},
+  {"hashKey": {"S": "a"}, "rangeKey": {"N": "1"}}
+]`),
+				ExpectError: regexache.MustCompile(`item 1: duplicate key of item 0`),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_source(t *testing.T) {
+	ctx := acctest.Context(t)
+	tableName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "items.csv")
+	jsonlPath := filepath.Join(dir, "items.jsonl")
+
+	var csvContent strings.Builder
+	csvContent.WriteString("hashKey,count,enabled\n")
+	for i := range 60 {
+		fmt.Fprintf(&csvContent, "item-%d,%d,true\n", i, i)
+	}
+	if err := os.WriteFile(csvPath, []byte(csvContent.String()), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(jsonlPath, []byte(`{"hashKey": "item-0", "count": 100}
+{"hashKey": "item-1", "tags": ["x", "y"]}
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_sourceCSV(tableName, csvPath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 60),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "60"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.format", "CSV"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_sourceJSONL(tableName, jsonlPath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(ctx, tableName, 2),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.format", "JSONL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableItemsJSON(n int) string {
+	items := make([]string, 0, n)
+	for i := range n {
+		items = append(items, fmt.Sprintf(`{"hashKey": {"S": "item-%[1]d"}, "value": {"N": "%[1]d"}}`, i))
+	}
+
+	return "[" + strings.Join(items, ",\n") + "]"
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			// The items are removed with the table.
+			conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+			_, err := tfdynamodb.FindTableByName(ctx, conn, rs.Primary.Attributes[names.AttrTableName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DynamoDB Table %s still exists", rs.Primary.Attributes[names.AttrTableName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsItemExists(ctx context.Context, tableName, hashKeyValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		_, err := tfdynamodb.FindTableItemByTwoPartKey(ctx, conn, tableName, map[string]awstypes.AttributeValue{
+			"hashKey": &awstypes.AttributeValueMemberS{Value: hashKeyValue},
+		})
+
+		return err
+	}
+}
+
+func testAccCheckTableItemsItemNotExists(ctx context.Context, tableName, hashKeyValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		_, err := tfdynamodb.FindTableItemByTwoPartKey(ctx, conn, tableName, map[string]awstypes.AttributeValue{
+			"hashKey": &awstypes.AttributeValueMemberS{Value: hashKeyValue},
+		})
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DynamoDB Table (%s) Item %s still exists", tableName, hashKeyValue)
+	}
+}
+
+func testAccCheckTableItemsContentDigest(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*v = rs.Primary.Attributes["content_digest"]
+
+		return nil
+	}
+}
+
+func testAccTableItemsConfig_base(tableName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "hashKey"
+
+  attribute {
+    name = "hashKey"
+    type = "S"
+  }
+}
+`, tableName)
+}
+
+func testAccTableItemsConfig_basic(tableName, items string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(tableName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = <<ITEMS
+%[1]s
+ITEMS
+}
+`, items))
+}
+
+func testAccTableItemsConfig_rangeKey(tableName, items string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "hashKey"
+  range_key    = "rangeKey"
+
+  attribute {
+    name = "hashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "rangeKey"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = <<ITEMS
+%[2]s
+ITEMS
+}
+`, tableName, items)
+}
+
+func testAccTableItemsConfig_sourceCSV(tableName, path string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(tableName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  source {
+    format = "CSV"
+    path   = %[1]q
+
+    type_mapping = {
+      count   = "N"
+      enabled = "BOOL"
+    }
+  }
+}
+`, path))
+}
+
+func testAccTableItemsConfig_sourceJSONL(tableName, path string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(tableName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  source {
+    format = "JSONL"
+    path   = %[1]q
+
+    type_mapping = {
+      tags = "SS"
+    }
+  }
+}
+`, path))
+}
@@ -5,9 +5,11 @@ package ecs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -1000,6 +1002,11 @@ func resourceService() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"wait_for_steady_state_fail_fast": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"volume_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1286,7 +1293,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	fn := waitServiceActive
 	if d.Get("wait_for_steady_state").(bool) {
-		fn = waitServiceStable
+		fn = waitServiceStableFunc(d.Get("wait_for_steady_state_fail_fast").(bool))
 	}
 	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
@@ -1601,7 +1608,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 		fn := waitServiceActive
 		if d.Get("wait_for_steady_state").(bool) {
-			fn = waitServiceStable
+			fn = waitServiceStableFunc(d.Get("wait_for_steady_state_fail_fast").(bool))
 		}
 		if _, err := fn(ctx, conn, d.Id(), cluster, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
@@ -1873,6 +1880,7 @@ const (
 	serviceStatusDraining = "DRAINING"
 
 	// Non-standard statuses for statusServiceWaitForStable().
+	serviceStatusFailed  = "tfFAILED"
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 )
@@ -1893,7 +1901,7 @@ func statusService(ctx context.Context, conn *ecs.Client, serviceName, clusterNa
 	}
 }
 
-func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string) retry.StateRefreshFunc {
+func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, failFast bool) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		outputRaw, status, err := statusService(ctx, conn, serviceName, clusterNameOrARN)()
 
@@ -1909,6 +1917,10 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceNa
 
 		if n, dc, rc := len(output.Deployments), output.DesiredCount, output.RunningCount; n == 1 && dc == rc {
 			status = serviceStatusStable
+		} else if failFast && slices.ContainsFunc(output.Deployments, func(v awstypes.Deployment) bool {
+			return v.RolloutState == awstypes.DeploymentRolloutStateFailed
+		}) {
+			status = serviceStatusFailed
 		} else {
 			status = serviceStatusPending
 		}
@@ -1918,17 +1930,24 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceNa
 }
 
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running.
+// If failFast is true, waiting stops as soon as a deployment's rollout fails, e.g. when the deployment circuit breaker triggers.
+// On failure, the returned error describes the service's deployments, recent events and recently stopped tasks.
 // Does not return tags.
-func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, timeout time.Duration) (*awstypes.Service, error) {
+func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, failFast bool, timeout time.Duration) (*awstypes.Service, error) {
+	since := time.Now()
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, serviceName, clusterNameOrARN),
+		Refresh: statusServiceWaitForStable(ctx, conn, serviceName, clusterNameOrARN, failFast),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
+	if err != nil {
+		tfresource.SetLastError(err, serviceDeploymentError(ctx, conn, serviceName, clusterNameOrARN, since))
+	}
+
 	if output, ok := outputRaw.(*awstypes.Service); ok {
 		return output, err
 	}
@@ -1936,6 +1955,140 @@ func waitServiceStable(ctx context.Context, conn *ecs.Client, serviceName, clust
 	return nil, err
 }
 
+func waitServiceStableFunc(failFast bool) func(context.Context, *ecs.Client, string, string, time.Duration) (*awstypes.Service, error) {
+	return func(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, timeout time.Duration) (*awstypes.Service, error) {
+		return waitServiceStable(ctx, conn, serviceName, clusterNameOrARN, failFast, timeout)
+	}
+}
+
+const (
+	// serviceDeploymentErrorMaxEvents is the maximum number of service events included in serviceDeploymentError.
+	serviceDeploymentErrorMaxEvents = 10
+	// serviceDeploymentErrorMaxStoppedTasks is the maximum number of stopped tasks included in serviceDeploymentError.
+	serviceDeploymentErrorMaxStoppedTasks = 10
+)
+
+// serviceDeploymentError returns an error describing why an ECS Service has not reached a steady state:
+// the status of each deployment, including its rollout state and failed task count,
+// the service events and the stopped reasons and container exit codes of the tasks stopped since the specified time.
+// Errors encountered while gathering the description are ignored.
+func serviceDeploymentError(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, since time.Time) error {
+	service, err := findServiceNoTagsByTwoPartKey(ctx, conn, serviceName, clusterNameOrARN)
+
+	if err != nil {
+		return nil
+	}
+
+	var details []error
+
+	for _, v := range service.Deployments {
+		msg := fmt.Sprintf("deployment %s (%s, task definition %s): %d/%d tasks running, %d pending, %d failed", aws.ToString(v.Id), aws.ToString(v.Status), aws.ToString(v.TaskDefinition), v.RunningCount, v.DesiredCount, v.PendingCount, v.FailedTasks)
+		if v.RolloutState != "" {
+			msg += fmt.Sprintf("; rollout state %s", v.RolloutState)
+			if reason := aws.ToString(v.RolloutStateReason); reason != "" {
+				msg += fmt.Sprintf(": %s", reason)
+			}
+		}
+		details = append(details, errors.New(msg))
+	}
+
+	if v := service.DeploymentConfiguration; v != nil && v.DeploymentCircuitBreaker != nil && v.DeploymentCircuitBreaker.Enable {
+		details = append(details, fmt.Errorf("deployment circuit breaker enabled (rollback: %t)", v.DeploymentCircuitBreaker.Rollback))
+	}
+
+	var events []string
+	for _, v := range service.Events {
+		if len(events) == serviceDeploymentErrorMaxEvents {
+			break
+		}
+		if v.CreatedAt != nil && v.CreatedAt.Before(since) {
+			continue
+		}
+		events = append(events, fmt.Sprintf("  %s %s", aws.ToTime(v.CreatedAt).Format(time.RFC3339), aws.ToString(v.Message)))
+	}
+	if len(events) > 0 {
+		details = append(details, fmt.Errorf("service events:\n%s", strings.Join(events, "\n")))
+	}
+
+	tasks, err := findStoppedTasksByServiceName(ctx, conn, aws.ToString(service.ServiceName), aws.ToString(service.ClusterArn), serviceDeploymentErrorMaxStoppedTasks)
+
+	if err == nil {
+		for _, v := range tasks {
+			if v.StoppedAt != nil && v.StoppedAt.Before(since) {
+				continue
+			}
+			details = append(details, stoppedTaskError(&v))
+		}
+	}
+
+	return errors.Join(details...)
+}
+
+// stoppedTaskError returns an error describing why an ECS Task stopped.
+func stoppedTaskError(apiObject *awstypes.Task) error {
+	msg := fmt.Sprintf("task %s stopped", aws.ToString(apiObject.TaskArn))
+	if apiObject.StopCode != "" {
+		msg += fmt.Sprintf(" (%s)", apiObject.StopCode)
+	}
+	if reason := aws.ToString(apiObject.StoppedReason); reason != "" {
+		msg += fmt.Sprintf(": %s", reason)
+	}
+
+	for _, v := range apiObject.Containers {
+		if v.ExitCode == nil && v.Reason == nil {
+			continue
+		}
+
+		msg += fmt.Sprintf("\n  container %s", aws.ToString(v.Name))
+		if v.ExitCode != nil {
+			msg += fmt.Sprintf(" exit code %d", aws.ToInt32(v.ExitCode))
+		}
+		if reason := aws.ToString(v.Reason); reason != "" {
+			msg += fmt.Sprintf(": %s", reason)
+		}
+	}
+
+	return errors.New(msg)
+}
+
+func findStoppedTasksByServiceName(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, maxResults int32) ([]awstypes.Task, error) {
+	input := &ecs.ListTasksInput{
+		Cluster:       aws.String(clusterNameOrARN),
+		DesiredStatus: awstypes.DesiredStatusStopped,
+		MaxResults:    aws.Int32(maxResults),
+		ServiceName:   aws.String(serviceName),
+	}
+
+	output, err := conn.ListTasks(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	return findTasks(ctx, conn, &ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterNameOrARN),
+		Tasks:   output.TaskArns,
+	})
+}
+
+func findTasks(ctx context.Context, conn *ecs.Client, input *ecs.DescribeTasksInput) ([]awstypes.Task, error) {
+	output, err := conn.DescribeTasks(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Tasks, nil
+}
+
 // Does not return tags.
 func waitServiceActive(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string, timeout time.Duration) (*awstypes.Service, error) {
 	stateConf := &retry.StateChangeConf{
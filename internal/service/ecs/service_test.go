@@ -148,8 +148,8 @@ func TestAccECSService_basicImport(t *testing.T) {
 				ImportStateId:     importInput,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// wait_for_steady_state and wait_for_steady_state_fail_fast are not read from API
+				ImportStateVerifyIgnore: []string{"wait_for_steady_state", "wait_for_steady_state_fail_fast"},
 			},
 			// Test non-existent resource import
 			{
@@ -594,8 +594,8 @@ func TestAccECSService_DeploymentControllerType_codeDeploy(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_steady_state and wait_for_steady_state_fail_fast are not read from API
+				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state", "wait_for_steady_state_fail_fast"},
 			},
 		},
 	})
@@ -669,8 +669,8 @@ func TestAccECSService_DeploymentControllerType_external(t *testing.T) {
 				ImportStateId:     fmt.Sprintf("%s/%s", rName, rName),
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// wait_for_steady_state and wait_for_steady_state_fail_fast are not read from API
+				ImportStateVerifyIgnore: []string{"wait_for_steady_state", "wait_for_steady_state_fail_fast"},
 			},
 		},
 	})
@@ -1216,8 +1216,26 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_steady_state and wait_for_steady_state_fail_fast are not read from API
+				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state", "wait_for_steady_state_fail_fast"},
+			},
+		},
+	})
+}
+
+func TestAccECSService_LaunchTypeFargate_waitForSteadyStateFailFast(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceConfig_launchTypeFargateWaitFailFast(rName),
+				ExpectError: regexache.MustCompile(`(?s)rollout state FAILED.*container test exit code 3`),
 			},
 		},
 	})
@@ -1656,8 +1674,8 @@ func TestAccECSService_Tags_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_steady_state and wait_for_steady_state_fail_fast are not read from API
+				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state", "wait_for_steady_state_fail_fast"},
 			},
 			{
 				Config: testAccServiceConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
@@ -2107,6 +2125,51 @@ resource "aws_ecs_service" "test" {
 `, rName, desiredCount, waitForSteadyState))
 }
 
+func testAccServiceConfig_launchTypeFargateWaitFailFast(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "failing" {
+  family                   = "%[1]s-failing"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "command": ["sh", "-c", "exit 3"],
+    "essential": true,
+    "image": "public.ecr.aws/docker/library/busybox:latest",
+    "name": "test"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.failing.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = false
+  }
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  wait_for_steady_state           = true
+  wait_for_steady_state_fail_fast = true
+}
+`, rName))
+}
+
 func testAccServiceConfig_interchangeablePlacementStrategy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -929,12 +929,12 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
-func findClusterByName(ctx context.Context, conn *eks.Client, name string) (*types.Cluster, error) {
+func findClusterByName(ctx context.Context, conn *eks.Client, name string, optFns ...func(*eks.Options)) (*types.Cluster, error) {
 	input := &eks.DescribeClusterInput{
 		Name: aws.String(name),
 	}
 
-	output, err := conn.DescribeCluster(ctx, input)
+	output, err := conn.DescribeCluster(ctx, input, optFns...)
 
 	// Sometimes the EKS API returns the ResourceNotFound error in this form:
 	// ClientException: No cluster found for name: tf-acc-test-0o1f8
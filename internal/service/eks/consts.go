@@ -56,3 +56,17 @@ func nodePoolType_Values() []string {
 		nodePoolSystem,
 	}
 }
+
+type kubeconfigAuthType string
+
+const (
+	kubeconfigAuthTypeExec  kubeconfigAuthType = "EXEC"
+	kubeconfigAuthTypeToken kubeconfigAuthType = "TOKEN"
+)
+
+func (kubeconfigAuthType) Values() []kubeconfigAuthType {
+	return []kubeconfigAuthType{
+		kubeconfigAuthTypeExec,
+		kubeconfigAuthTypeToken,
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// kubeconfigModel is the model shared by the aws_eks_kubeconfig data source and ephemeral resource.
+type kubeconfigModel struct {
+	Clusters       fwtypes.ListNestedObjectValueOf[kubeconfigClusterModel] `tfsdk:"cluster"`
+	CurrentContext types.String                                            `tfsdk:"current_context"`
+	Kubeconfig     types.String                                            `tfsdk:"kubeconfig"`
+}
+
+type kubeconfigClusterModel struct {
+	AuthType    fwtypes.StringEnum[kubeconfigAuthType] `tfsdk:"auth_type"`
+	ContextName types.String                           `tfsdk:"context_name"`
+	Name        types.String                           `tfsdk:"name"`
+	Namespace   types.String                           `tfsdk:"namespace"`
+	Profile     types.String                           `tfsdk:"profile"`
+	Region      types.String                           `tfsdk:"region"`
+	RoleARN     fwtypes.ARN                            `tfsdk:"role_arn"`
+}
+
+// buildKubeconfig renders the kubeconfig document described by data.
+func buildKubeconfig(ctx context.Context, c *conns.AWSClient, data *kubeconfigModel) (string, error) {
+	clusters, diags := data.Clusters.ToSlice(ctx)
+	if diags.HasError() {
+		return "", errors.New("reading cluster configuration")
+	}
+
+	conn := c.EKSClient(ctx)
+	var generator Generator
+	builder := newKubeconfigBuilder()
+
+	for _, v := range clusters {
+		name := v.Name.ValueString()
+		authType := v.AuthType.ValueEnum()
+		if authType == "" {
+			authType = kubeconfigAuthTypeExec
+		}
+
+		// The cluster is looked up in its Region, which is also passed to `aws eks get-token`.
+		region := v.Region.ValueString()
+		if region == "" {
+			region = c.Region(ctx)
+		}
+
+		var user kubeconfigUser
+		switch authType {
+		case kubeconfigAuthTypeExec:
+			user.Exec = newKubeconfigExec(name, region, v.RoleARN.ValueString(), v.Profile.ValueString())
+
+		case kubeconfigAuthTypeToken:
+			if !v.Profile.IsNull() || !v.Region.IsNull() || !v.RoleARN.IsNull() {
+				return "", fmt.Errorf("cluster (%s): profile, region and role_arn are only supported with auth_type %q", name, kubeconfigAuthTypeExec)
+			}
+
+			if generator == nil {
+				var err error
+				generator, err = NewGenerator(false, false)
+				if err != nil {
+					return "", err
+				}
+			}
+
+			token, err := generator.GetWithSTS(ctx, name, c.STSClient(ctx))
+			if err != nil {
+				return "", fmt.Errorf("generating EKS Cluster (%s) Authentication Token: %w", name, err)
+			}
+
+			user.Token = token.Token
+		}
+
+		cluster, err := findClusterByName(ctx, conn, name, func(o *eks.Options) {
+			o.Region = region
+		})
+		if err != nil {
+			return "", fmt.Errorf("reading EKS Cluster (%s): %w", name, err)
+		}
+
+		if err := builder.add(cluster, v.ContextName.ValueString(), v.Namespace.ValueString(), user); err != nil {
+			return "", err
+		}
+	}
+
+	return builder.render(data.CurrentContext.ValueString())
+}
+
+// kubeconfig is a Kubernetes client configuration document.
+// See https://kubernetes.io/docs/reference/config-api/kubeconfig.v1/.
+type kubeconfig struct {
+	APIVersion     string                   `yaml:"apiVersion"`
+	Clusters       []kubeconfigNamedCluster `yaml:"clusters"`
+	Contexts       []kubeconfigNamedContext `yaml:"contexts"`
+	CurrentContext string                   `yaml:"current-context"`
+	Kind           string                   `yaml:"kind"`
+	Preferences    struct{}                 `yaml:"preferences"`
+	Users          []kubeconfigNamedUser    `yaml:"users"`
+}
+
+type kubeconfigNamedCluster struct {
+	Cluster kubeconfigCluster `yaml:"cluster"`
+	Name    string            `yaml:"name"`
+}
+
+type kubeconfigCluster struct {
+	CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	Server                   string `yaml:"server"`
+}
+
+type kubeconfigNamedContext struct {
+	Context kubeconfigContext `yaml:"context"`
+	Name    string            `yaml:"name"`
+}
+
+type kubeconfigContext struct {
+	Cluster   string `yaml:"cluster"`
+	Namespace string `yaml:"namespace,omitempty"`
+	User      string `yaml:"user"`
+}
+
+type kubeconfigNamedUser struct {
+	Name string         `yaml:"name"`
+	User kubeconfigUser `yaml:"user"`
+}
+
+type kubeconfigUser struct {
+	Exec  *kubeconfigExec `yaml:"exec,omitempty"`
+	Token string          `yaml:"token,omitempty"`
+}
+
+type kubeconfigExec struct {
+	APIVersion string              `yaml:"apiVersion"`
+	Args       []string            `yaml:"args"`
+	Command    string              `yaml:"command"`
+	Env        []kubeconfigExecEnv `yaml:"env,omitempty"`
+}
+
+type kubeconfigExecEnv struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// newKubeconfigExec returns an exec stanza that obtains a token using `aws eks get-token`, as written by `aws eks update-kubeconfig`.
+func newKubeconfigExec(clusterName, region, roleARN, profile string) *kubeconfigExec {
+	exec := &kubeconfigExec{
+		APIVersion: "client.authentication.k8s.io/v1beta1",
+		Args:       []string{"--region", region, "eks", "get-token", "--cluster-name", clusterName, "--output", "json"},
+		Command:    "aws",
+	}
+
+	if roleARN != "" {
+		exec.Args = append(exec.Args, "--role-arn", roleARN)
+	}
+
+	if profile != "" {
+		exec.Env = append(exec.Env, kubeconfigExecEnv{Name: "AWS_PROFILE", Value: profile})
+	}
+
+	return exec
+}
+
+type kubeconfigBuilder struct {
+	clusters map[string]struct{}
+	config   kubeconfig
+}
+
+func newKubeconfigBuilder() *kubeconfigBuilder {
+	return &kubeconfigBuilder{
+		clusters: make(map[string]struct{}),
+		config: kubeconfig{
+			APIVersion: "v1",
+			Kind:       "Config",
+		},
+	}
+}
+
+// add adds a context, and the corresponding user, for the specified cluster.
+// Clusters are named by ARN. If contextName is empty, the context is also named by the cluster's ARN.
+func (b *kubeconfigBuilder) add(cluster *awstypes.Cluster, contextName, namespace string, user kubeconfigUser) error {
+	clusterARN := aws.ToString(cluster.Arn)
+	if contextName == "" {
+		contextName = clusterARN
+	}
+
+	if b.hasContext(contextName) {
+		return fmt.Errorf("duplicate context name (%s)", contextName)
+	}
+
+	if _, ok := b.clusters[clusterARN]; !ok {
+		var caData string
+		if cluster.CertificateAuthority != nil {
+			caData = aws.ToString(cluster.CertificateAuthority.Data)
+		}
+
+		b.clusters[clusterARN] = struct{}{}
+		b.config.Clusters = append(b.config.Clusters, kubeconfigNamedCluster{
+			Cluster: kubeconfigCluster{
+				CertificateAuthorityData: caData,
+				Server:                   aws.ToString(cluster.Endpoint),
+			},
+			Name: clusterARN,
+		})
+	}
+
+	b.config.Contexts = append(b.config.Contexts, kubeconfigNamedContext{
+		Context: kubeconfigContext{
+			Cluster:   clusterARN,
+			Namespace: namespace,
+			User:      contextName,
+		},
+		Name: contextName,
+	})
+	b.config.Users = append(b.config.Users, kubeconfigNamedUser{
+		Name: contextName,
+		User: user,
+	})
+
+	return nil
+}
+
+// render returns the kubeconfig document as YAML.
+// If currentContext is empty, the first context added is the current context.
+func (b *kubeconfigBuilder) render(currentContext string) (string, error) {
+	if len(b.config.Contexts) == 0 {
+		return "", errors.New("no clusters configured")
+	}
+
+	if currentContext == "" {
+		currentContext = b.config.Contexts[0].Name
+	} else if !b.hasContext(currentContext) {
+		return "", fmt.Errorf("current context (%s) not found", currentContext)
+	}
+
+	b.config.CurrentContext = currentContext
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(&b.config); err != nil {
+		return "", err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func (b *kubeconfigBuilder) hasContext(name string) bool {
+	for _, v := range b.config.Contexts {
+		if v.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
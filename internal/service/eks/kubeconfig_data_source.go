@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isometry/terraform-provider-faws/internal/create"
+	"github.com/isometry/terraform-provider-faws/internal/framework"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	DSNameKubeconfig = "Kubeconfig Data Source"
+)
+
+// @FrameworkDataSource("aws_eks_kubeconfig", name="Kubeconfig")
+func newKubeconfigDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &kubeconfigDataSource{}, nil
+}
+
+type kubeconfigDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *kubeconfigDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_eks_kubeconfig"
+}
+
+func (d *kubeconfigDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"current_context": schema.StringAttribute{
+				Optional: true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cluster": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kubeconfigClusterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[kubeconfigAuthType](),
+							Optional:   true,
+						},
+						"context_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrNamespace: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrProfile: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrRegion: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *kubeconfigDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data kubeconfigModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	kubeconfig, err := buildKubeconfig(ctx, d.Meta(), &data)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, DSNameKubeconfig, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Kubeconfig = types.StringValue(kubeconfig)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/names"
+	"gopkg.in/yaml.v3"
+)
+
+func TestAccEKSKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubeconfigContexts(dataSourceName, rName, "admin"),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`current-context: admin`)),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`--role-arn`)),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`token: k8s-aws-v1\.`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubeconfigContexts(n string, contextNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var kubeconfig struct {
+			Contexts []struct {
+				Name string `yaml:"name"`
+			} `yaml:"contexts"`
+		}
+
+		if err := yaml.Unmarshal([]byte(rs.Primary.Attributes["kubeconfig"]), &kubeconfig); err != nil {
+			return err
+		}
+
+		if got, want := len(kubeconfig.Contexts), len(contextNames); got != want {
+			return fmt.Errorf("kubeconfig has %d contexts, expected %d", got, want)
+		}
+
+		for i, v := range kubeconfig.Contexts {
+			if v.Name != contextNames[i] {
+				return fmt.Errorf("kubeconfig context %d is %q, expected %q", i, v.Name, contextNames[i])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccKubeconfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+data "aws_eks_kubeconfig" "test" {
+  current_context = "admin"
+
+  cluster {
+    name         = aws_eks_cluster.test.name
+    context_name = %[1]q
+    auth_type    = "TOKEN"
+  }
+
+  cluster {
+    name         = aws_eks_cluster.test.name
+    context_name = "admin"
+    namespace    = "kube-system"
+    role_arn     = aws_iam_role.cluster.arn
+  }
+}
+`, rName))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isometry/terraform-provider-faws/internal/create"
+	"github.com/isometry/terraform-provider-faws/internal/framework"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	ERNameKubeconfig = "Ephemeral Resource Kubeconfig"
+)
+
+// @EphemeralResource(aws_eks_kubeconfig, name="Kubeconfig")
+func newEphemeralKubeconfig(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralKubeconfig{}, nil
+}
+
+type ephemeralKubeconfig struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralKubeconfig) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_eks_kubeconfig"
+}
+
+func (e *ephemeralKubeconfig) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"current_context": schema.StringAttribute{
+				Optional: true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cluster": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kubeconfigClusterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[kubeconfigAuthType](),
+							Optional:   true,
+						},
+						"context_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrNamespace: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrProfile: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrRegion: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralKubeconfig) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data kubeconfigModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	kubeconfig, err := buildKubeconfig(ctx, e.Meta(), &data)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, ERNameKubeconfig, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Kubeconfig = types.StringValue(kubeconfig)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
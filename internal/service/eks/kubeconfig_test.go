@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKubeconfigBuilder(t *testing.T) {
+	t.Parallel()
+
+	cluster1 := &awstypes.Cluster{
+		Arn:                  aws.String("arn:aws:eks:us-west-2:123456789012:cluster/one"), //lintignore:AWSAT003,AWSAT005
+		CertificateAuthority: &awstypes.Certificate{Data: aws.String("Q0EtT05F")},
+		Endpoint:             aws.String("https://one.example.com"),
+	}
+	cluster2 := &awstypes.Cluster{
+		Arn:                  aws.String("arn:aws:eks:us-west-2:123456789012:cluster/two"), //lintignore:AWSAT003,AWSAT005
+		CertificateAuthority: &awstypes.Certificate{Data: aws.String("Q0EtVFdP")},
+		Endpoint:             aws.String("https://two.example.com"),
+	}
+
+	builder := newKubeconfigBuilder()
+
+	if err := builder.add(cluster1, "", "", kubeconfigUser{Exec: newKubeconfigExec("one", "us-west-2", "", "")}); err != nil { //lintignore:AWSAT003
+		t.Fatal(err)
+	}
+	if err := builder.add(cluster1, "one-admin", "kube-system", kubeconfigUser{Exec: newKubeconfigExec("one", "us-west-2", "arn:aws:iam::123456789012:role/admin", "ci")}); err != nil { //lintignore:AWSAT003,AWSAT005
+		t.Fatal(err)
+	}
+	if err := builder.add(cluster2, "two", "", kubeconfigUser{Token: "k8s-aws-v1.token"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := builder.add(cluster2, "two", "", kubeconfigUser{Token: "k8s-aws-v1.token"}); err == nil {
+		t.Fatal("expected error for duplicate context name")
+	}
+
+	if _, err := builder.render("three"); err == nil {
+		t.Fatal("expected error for unknown current context")
+	}
+
+	got, err := builder.render("two")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//lintignore:AWSAT003,AWSAT005
+	want := `apiVersion: v1
+clusters:
+  - cluster:
+      certificate-authority-data: Q0EtT05F
+      server: https://one.example.com
+    name: arn:aws:eks:us-west-2:123456789012:cluster/one
+  - cluster:
+      certificate-authority-data: Q0EtVFdP
+      server: https://two.example.com
+    name: arn:aws:eks:us-west-2:123456789012:cluster/two
+contexts:
+  - context:
+      cluster: arn:aws:eks:us-west-2:123456789012:cluster/one
+      user: arn:aws:eks:us-west-2:123456789012:cluster/one
+    name: arn:aws:eks:us-west-2:123456789012:cluster/one
+  - context:
+      cluster: arn:aws:eks:us-west-2:123456789012:cluster/one
+      namespace: kube-system
+      user: one-admin
+    name: one-admin
+  - context:
+      cluster: arn:aws:eks:us-west-2:123456789012:cluster/two
+      user: two
+    name: two
+current-context: two
+kind: Config
+preferences: {}
+users:
+  - name: arn:aws:eks:us-west-2:123456789012:cluster/one
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1beta1
+        args:
+          - --region
+          - us-west-2
+          - eks
+          - get-token
+          - --cluster-name
+          - one
+          - --output
+          - json
+        command: aws
+  - name: one-admin
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1beta1
+        args:
+          - --region
+          - us-west-2
+          - eks
+          - get-token
+          - --cluster-name
+          - one
+          - --output
+          - json
+          - --role-arn
+          - arn:aws:iam::123456789012:role/admin
+        command: aws
+        env:
+          - name: AWS_PROFILE
+            value: ci
+  - name: two
+    user:
+      token: k8s-aws-v1.token
+`
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestKubeconfigBuilder_empty(t *testing.T) {
+	t.Parallel()
+
+	if _, err := newKubeconfigBuilder().render(""); err == nil {
+		t.Fatal("expected error")
+	}
+}
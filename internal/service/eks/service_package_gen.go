@@ -21,11 +21,22 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_eks_cluster_auth",
 			Name:     "ClusterAuth",
 		},
+		{
+			Factory:  newEphemeralKubeconfig,
+			TypeName: "aws_eks_kubeconfig",
+			Name:     "Kubeconfig",
+		},
 	}
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newKubeconfigDataSource,
+			TypeName: "aws_eks_kubeconfig",
+			Name:     "Kubeconfig",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
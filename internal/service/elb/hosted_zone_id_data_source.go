@@ -76,6 +76,11 @@ func dataSourceHostedZoneIDRead(ctx context.Context, d *schema.ResourceData, met
 		region = v.(string)
 	}
 
+	if v, ok := meta.(*conns.AWSClient).CustomPartitionHostedZoneID(ctx, region, conns.HostedZoneIDKeyELB); ok {
+		d.SetId(v)
+		return diags
+	}
+
 	if v, ok := hostedZoneIDPerRegionMap[region]; ok {
 		d.SetId(v)
 		return diags
@@ -134,13 +134,17 @@ func dataSourceHostedZoneIDRead(ctx context.Context, d *schema.ResourceData, met
 
 	switch lbType {
 	case awstypes.LoadBalancerTypeEnumApplication:
-		if v, ok := hostedZoneIDPerRegionALBMap[region]; ok {
+		if v, ok := meta.(*conns.AWSClient).CustomPartitionHostedZoneID(ctx, region, conns.HostedZoneIDKeyALB); ok {
+			d.SetId(v)
+		} else if v, ok := hostedZoneIDPerRegionALBMap[region]; ok {
 			d.SetId(v)
 		} else {
 			return sdkdiag.AppendErrorf(diags, "unsupported AWS Region: %s", region)
 		}
 	case awstypes.LoadBalancerTypeEnumNetwork:
-		if v, ok := hostedZoneIDPerRegionNLBMap[region]; ok {
+		if v, ok := meta.(*conns.AWSClient).CustomPartitionHostedZoneID(ctx, region, conns.HostedZoneIDKeyNLB); ok {
+			d.SetId(v)
+		} else if v, ok := hostedZoneIDPerRegionNLBMap[region]; ok {
 			d.SetId(v)
 		} else {
 			return sdkdiag.AppendErrorf(diags, "unsupported AWS Region: %s", region)
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
+	"github.com/isometry/terraform-provider-faws/internal/flex"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	jobRunResourceIDPartCount = 2
+
+	// Default log group of job runs without continuous logging or a custom log group.
+	jobRunDefaultLogGroupName = "/aws-glue/jobs"
+)
+
+// @SDKResource("aws_glue_job_run", name="Job Run")
+func resourceJobRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobRunCreate,
+		ReadWithoutTimeout:   resourceJobRunRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arguments": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_run_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrLogGroupName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"logs_deep_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"number_of_workers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"worker_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.WorkerType](),
+			},
+		},
+	}
+}
+
+func resourceJobRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueClient(ctx)
+
+	jobName := d.Get("job_name").(string)
+	input := &glue.StartJobRunInput{
+		JobName: aws.String(jobName),
+	}
+
+	if v, ok := d.GetOk("arguments"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Arguments = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("worker_type"); ok {
+		input.WorkerType = awstypes.WorkerType(v.(string))
+	}
+
+	output, err := conn.StartJobRun(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Glue Job (%s) run: %s", jobName, err)
+	}
+
+	runID := aws.ToString(output.JobRunId)
+	id, err := flex.FlattenResourceId([]string{jobName, runID}, jobRunResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	jobRun, err := waitJobRunComplete(ctx, conn, jobName, runID, d.Timeout(schema.TimeoutCreate))
+
+	if jobRun != nil {
+		resourceJobRunFlatten(ctx, d, meta.(*conns.AWSClient), jobRun)
+	}
+
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || tfresource.TimedOut(err) {
+			log.Printf("[DEBUG] Stopping Glue Job Run (%s)", d.Id())
+			stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 1*time.Minute)
+			defer cancel()
+			if _, err := conn.BatchStopJobRun(stopCtx, &glue.BatchStopJobRunInput{JobName: aws.String(jobName), JobRunIds: []string{runID}}); err != nil {
+				log.Printf("[WARN] Stopping Glue Job Run (%s): %s", d.Id(), err)
+			}
+		}
+
+		if v := d.Get("logs_deep_link").(string); v != "" {
+			err = fmt.Errorf("%w; logs: %s", err, v)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "waiting for Glue Job Run (%s) to complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceJobRunRead(ctx, d, meta)...)
+}
+
+func resourceJobRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobRunResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	jobName, runID := parts[0], parts[1]
+	jobRun, err := findJobRunByTwoPartKey(ctx, conn, jobName, runID)
+
+	// Job runs are retained for a limited time. The resource represents a completed run, so it is kept in state.
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Job Run (%s) not found, keeping in state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Job Run (%s): %s", d.Id(), err)
+	}
+
+	resourceJobRunFlatten(ctx, d, meta.(*conns.AWSClient), jobRun)
+
+	return diags
+}
+
+func resourceJobRunFlatten(ctx context.Context, d *schema.ResourceData, c *conns.AWSClient, jobRun *awstypes.JobRun) {
+	if v := jobRun.CompletedOn; v != nil {
+		d.Set("completed_on", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("error_message", jobRun.ErrorMessage)
+	d.Set("execution_time", jobRun.ExecutionTime)
+	d.Set("job_name", jobRun.JobName)
+	d.Set("job_run_id", jobRun.Id)
+	d.Set("job_run_state", jobRun.JobRunState)
+	d.Set(names.AttrLogGroupName, jobRun.LogGroupName)
+	d.Set("logs_deep_link", jobRunLogsDeepLink(c.Partition(ctx), c.Region(ctx), jobRun))
+	if v := jobRun.StartedOn; v != nil {
+		d.Set("started_on", aws.ToTime(v).Format(time.RFC3339))
+	}
+}
+
+func findJobRunByTwoPartKey(ctx context.Context, conn *glue.Client, jobName, runID string) (*awstypes.JobRun, error) {
+	input := &glue.GetJobRunInput{
+		JobName: aws.String(jobName),
+		RunId:   aws.String(runID),
+	}
+
+	output, err := conn.GetJobRun(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobRun == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobRun, nil
+}
+
+func statusJobRun(ctx context.Context, conn *glue.Client, jobName, runID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobRunByTwoPartKey(ctx, conn, jobName, runID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobRunState), nil
+	}
+}
+
+func waitJobRunComplete(ctx context.Context, conn *glue.Client, jobName, runID string, timeout time.Duration) (*awstypes.JobRun, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.JobRunStateStarting, awstypes.JobRunStateRunning, awstypes.JobRunStateStopping, awstypes.JobRunStateWaiting),
+		Target:     enum.Slice(awstypes.JobRunStateSucceeded),
+		Refresh:    statusJobRun(ctx, conn, jobName, runID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.JobRun); ok {
+		if v := aws.ToString(output.ErrorMessage); v != "" {
+			tfresource.SetLastError(err, fmt.Errorf("state %s: %s", output.JobRunState, v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// jobRunLogsDeepLink returns the URL of a job run's error log stream in the CloudWatch Logs console,
+// or an empty string in partitions without a public console.
+func jobRunLogsDeepLink(partition, region string, jobRun *awstypes.JobRun) string {
+	var host string
+	switch partition {
+	case endpoints.AwsPartitionID:
+		host = "console.aws.amazon.com"
+	case endpoints.AwsCnPartitionID:
+		host = "console.amazonaws.cn"
+	case endpoints.AwsUsGovPartitionID:
+		host = "console.amazonaws-us-gov.com"
+	default:
+		return ""
+	}
+
+	logGroupName := aws.ToString(jobRun.LogGroupName)
+	if logGroupName == "" {
+		logGroupName = jobRunDefaultLogGroupName
+	}
+
+	// The console's fragment-based routing requires path components to be URL-encoded twice, with "%" written as "$25".
+	escape := func(v string) string {
+		return strings.ReplaceAll(url.QueryEscape(v), "%", "$25")
+	}
+
+	return fmt.Sprintf("https://%s/cloudwatch/home?region=%s#logsV2:log-groups/log-group/%s/log-events/%s", host, region, escape(logGroupName+"/error"), escape(aws.ToString(jobRun.Id)))
+}
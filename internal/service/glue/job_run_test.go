@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/flex"
+	tfglue "github.com/isometry/terraform-provider-faws/internal/service/glue"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+func TestAccGlueJobRun_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun awstypes.JobRun
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job_run.test"
+	jobResourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttrSet(resourceName, "completed_on"),
+					resource.TestCheckResourceAttr(resourceName, "error_message", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "job_name", jobResourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, "job_run_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_run_state", string(awstypes.JobRunStateSucceeded)),
+					resource.TestCheckResourceAttrSet(resourceName, "logs_deep_link"),
+					resource.TestCheckResourceAttrSet(resourceName, "started_on"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueJobRun_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun1, jobRun2 awstypes.JobRun
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_triggers(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config:   testAccJobRunConfig_triggers(rName, "1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccJobRunConfig_triggers(rName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun2),
+					testAccCheckJobRunRecreated(&jobRun1, &jobRun2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueJobRun_arguments(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun awstypes.JobRun
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_job_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_arguments(rName, "expected"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, "arguments.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "arguments.--expected", "expected"),
+					resource.TestCheckResourceAttr(resourceName, "job_run_state", string(awstypes.JobRunStateSucceeded)),
+				),
+			},
+			{
+				Config:      testAccJobRunConfig_arguments(rName, "unexpected"),
+				ExpectError: regexp.MustCompile(`state FAILED: .*unexpected.*; logs: https://`),
+			},
+		},
+	})
+}
+
+func testAccCheckJobRunExists(ctx context.Context, n string, v *awstypes.JobRun) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueClient(ctx)
+
+		output, err := tfglue.FindJobRunByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJobRunRecreated(before, after *awstypes.JobRun) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before == after {
+			return fmt.Errorf("Glue Job Run (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccJobRunConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+# AWSGlueServiceRole grants access to objects in buckets whose names begin with "aws-glue-".
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-glue-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "script.py"
+  content = <<EOF
+import sys
+from awsglue.utils import getResolvedOptions
+
+args = getResolvedOptions(sys.argv, ["expected"])
+if args["expected"] != "expected":
+    raise Exception("unexpected value: " + args["expected"])
+EOF
+}
+
+resource "aws_glue_job" "test" {
+  max_capacity = 0.0625
+  name         = %[1]q
+  role_arn     = aws_iam_role.test.arn
+
+  command {
+    name            = "pythonshell"
+    python_version  = "3.9"
+    script_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  default_arguments = {
+    "--expected" = "expected"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccJobRunConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), `
+resource "aws_glue_job_run" "test" {
+  job_name = aws_glue_job.test.name
+}
+`)
+}
+
+func testAccJobRunConfig_triggers(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job_run" "test" {
+  job_name = aws_glue_job.test.name
+
+  triggers = {
+    redeployment = %[1]q
+  }
+}
+`, trigger))
+}
+
+func testAccJobRunConfig_arguments(rName, value string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job_run" "test" {
+  job_name = aws_glue_job.test.name
+
+  arguments = {
+    "--expected" = %[1]q
+  }
+}
+`, value))
+}
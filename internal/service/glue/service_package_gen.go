@@ -124,6 +124,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceJobRun,
+			TypeName: "aws_glue_job_run",
+			Name:     "Job Run",
+		},
 		{
 			Factory:  ResourceMLTransform,
 			TypeName: "aws_glue_ml_transform",
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -15,11 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
 	"github.com/isometry/terraform-provider-faws/internal/errs"
 	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
 	"github.com/isometry/terraform-provider-faws/internal/flex"
 	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/internal/verify"
 	"github.com/isometry/terraform-provider-faws/names"
 )
 
@@ -35,11 +39,49 @@ func resourceAlias() *schema.Resource {
 			StateContext: resourceAliasImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deployment_strategy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"routing_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"interval_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[deploymentStrategyType](),
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -64,9 +106,10 @@ func resourceAlias() *schema.Resource {
 				ForceNew: true,
 			},
 			"routing_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"deployment_strategy"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"additional_version_weights": {
@@ -147,7 +190,27 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
-	_, err := conn.UpdateAlias(ctx, input)
+	deployment, err := expandAliasDeployment(d.Get("deployment_strategy").([]interface{}))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if o, n := d.GetChange("function_version"); deployment != nil && o.(string) != "" && o.(string) != n.(string) {
+		ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+
+		if err := deployAlias(ctx, conn, meta.(*conns.AWSClient).CloudWatchClient(ctx), deployment, input, o.(string)); err != nil {
+			// Retain the prior state, which reflects the rolled back alias.
+			d.Partial(true)
+
+			return sdkdiag.AppendErrorf(diags, "deploying Lambda Alias (%s) version %s: %s", d.Id(), n.(string), err)
+		}
+
+		return append(diags, resourceAliasRead(ctx, d, meta)...)
+	}
+
+	_, err = conn.UpdateAlias(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Lambda Alias (%s): %s", d.Id(), err)
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/flex"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	// aliasDeploymentAlarmPollInterval is the interval between checks of a deployment's alarms.
+	aliasDeploymentAlarmPollInterval = 15 * time.Second
+)
+
+// aliasDeployment describes the progressive shifting of an alias' traffic to a new function version.
+type aliasDeployment struct {
+	alarmNames []string
+	interval   time.Duration
+	weights    []float64
+}
+
+// aliasDeploymentAlarm is a CloudWatch alarm found in the ALARM state during a deployment.
+type aliasDeploymentAlarm struct {
+	name   string
+	reason string
+}
+
+// aliasDeploymentWeights returns the successive weights of traffic routed to the new version
+// before it becomes the alias' primary version.
+func aliasDeploymentWeights(strategyType deploymentStrategyType, percentage int) []float64 {
+	var weights []float64
+
+	switch strategyType {
+	case deploymentStrategyTypeCanary:
+		weights = append(weights, float64(percentage)/100)
+	case deploymentStrategyTypeLinear:
+		for v := percentage; v < 100; v += percentage {
+			weights = append(weights, float64(v)/100)
+		}
+	}
+
+	return weights
+}
+
+func expandAliasDeployment(tfList []interface{}) (*aliasDeployment, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	deployment := &aliasDeployment{
+		interval: time.Duration(tfMap["interval_seconds"].(int)) * time.Second,
+		weights:  aliasDeploymentWeights(deploymentStrategyType(tfMap[names.AttrType].(string)), tfMap["percentage"].(int)),
+	}
+
+	if v, ok := tfMap["alarm_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, v := range flex.ExpandStringValueSet(v) {
+			name, err := alarmNameFromARN(v)
+			if err != nil {
+				return nil, err
+			}
+			deployment.alarmNames = append(deployment.alarmNames, name)
+		}
+	}
+
+	return deployment, nil
+}
+
+func alarmNameFromARN(s string) (string, error) {
+	parsedARN, err := arn.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("parsing CloudWatch Alarm ARN (%s): %w", s, err)
+	}
+
+	name, ok := strings.CutPrefix(parsedARN.Resource, "alarm:")
+	if !ok || name == "" {
+		return "", fmt.Errorf("unexpected format for CloudWatch Alarm ARN (%s)", s)
+	}
+
+	return name, nil
+}
+
+// deployAlias progressively shifts the alias' traffic from version `from` to the version in input, checking
+// the deployment's alarms between steps. If any alarm is in the ALARM state, or a step fails, the alias is
+// rolled back to version `from` and an error returned.
+func deployAlias(ctx context.Context, conn *lambda.Client, cwConn *cloudwatch.Client, deployment *aliasDeployment, input *lambda.UpdateAliasInput, from string) error {
+	to := aws.ToString(input.FunctionVersion)
+
+	for _, weight := range deployment.weights {
+		log.Printf("[DEBUG] Shifting %g%% of Lambda Alias (%s) traffic to version %s", weight*100, aws.ToString(input.Name), to)
+		stepInput := *input
+		stepInput.FunctionVersion = aws.String(from)
+		stepInput.RoutingConfig = &awstypes.AliasRoutingConfiguration{
+			AdditionalVersionWeights: map[string]float64{to: weight},
+		}
+
+		_, err := conn.UpdateAlias(ctx, &stepInput)
+
+		if err != nil {
+			err = fmt.Errorf("shifting %g%% of traffic to version %s: %w", weight*100, to, err)
+			return rollbackAlias(ctx, conn, input, from, err)
+		}
+
+		alarm, err := waitAliasDeploymentInterval(ctx, cwConn, deployment)
+
+		if err != nil {
+			err = fmt.Errorf("checking CloudWatch Alarms with %g%% of traffic routed to version %s: %w", weight*100, to, err)
+			return rollbackAlias(ctx, conn, input, from, err)
+		}
+
+		if alarm != nil {
+			err = fmt.Errorf("CloudWatch Alarm (%s) in ALARM state with %g%% of traffic routed to version %s: %s", alarm.name, weight*100, to, alarm.reason)
+			return rollbackAlias(ctx, conn, input, from, err)
+		}
+	}
+
+	_, err := conn.UpdateAlias(ctx, input)
+
+	if err != nil {
+		err = fmt.Errorf("shifting all traffic to version %s: %w", to, err)
+		return rollbackAlias(ctx, conn, input, from, err)
+	}
+
+	return nil
+}
+
+// rollbackAlias routes all of the alias' traffic to version `from` and returns the error that caused the rollback.
+func rollbackAlias(ctx context.Context, conn *lambda.Client, input *lambda.UpdateAliasInput, from string, cause error) error {
+	// Roll back even if the deployment timed out.
+	ctx = context.WithoutCancel(ctx)
+
+	rollbackInput := *input
+	rollbackInput.FunctionVersion = aws.String(from)
+	rollbackInput.RoutingConfig = &awstypes.AliasRoutingConfiguration{}
+
+	if _, err := conn.UpdateAlias(ctx, &rollbackInput); err != nil {
+		return errors.Join(cause, fmt.Errorf("rolling back to version %s: %w", from, err))
+	}
+
+	return fmt.Errorf("%w; rolled back to version %s", cause, from)
+}
+
+// waitAliasDeploymentInterval waits for the deployment's interval, periodically checking its alarms.
+// The first alarm found in the ALARM state is returned.
+func waitAliasDeploymentInterval(ctx context.Context, conn *cloudwatch.Client, deployment *aliasDeployment) (*aliasDeploymentAlarm, error) {
+	deadline := time.Now().Add(deployment.interval)
+
+	for {
+		if len(deployment.alarmNames) > 0 {
+			alarm, err := findAliasDeploymentAlarm(ctx, conn, deployment.alarmNames)
+
+			if err != nil || alarm != nil {
+				return alarm, err
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(min(remaining, aliasDeploymentAlarmPollInterval)):
+		}
+	}
+}
+
+func findAliasDeploymentAlarm(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) (*aliasDeploymentAlarm, error) {
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+		StateValue: cloudwatchtypes.StateValueAlarm,
+	}
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.MetricAlarms) > 0 {
+			v := page.MetricAlarms[0]
+			return &aliasDeploymentAlarm{name: aws.ToString(v.AlarmName), reason: aws.ToString(v.StateReason)}, nil
+		}
+
+		if len(page.CompositeAlarms) > 0 {
+			v := page.CompositeAlarms[0]
+			return &aliasDeploymentAlarm{name: aws.ToString(v.AlarmName), reason: aws.ToString(v.StateReason)}, nil
+		}
+	}
+
+	return nil, nil
+}
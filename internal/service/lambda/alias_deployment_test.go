@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAliasDeploymentWeights(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		strategyType deploymentStrategyType
+		percentage   int
+		expected     []float64
+	}{
+		"canary": {
+			strategyType: deploymentStrategyTypeCanary,
+			percentage:   10,
+			expected:     []float64{0.1},
+		},
+		"linear": {
+			strategyType: deploymentStrategyTypeLinear,
+			percentage:   25,
+			expected:     []float64{0.25, 0.5, 0.75},
+		},
+		"linear uneven": {
+			strategyType: deploymentStrategyTypeLinear,
+			percentage:   40,
+			expected:     []float64{0.4, 0.8},
+		},
+		"linear 50": {
+			strategyType: deploymentStrategyTypeLinear,
+			percentage:   50,
+			expected:     []float64{0.5},
+		},
+		"linear 99": {
+			strategyType: deploymentStrategyTypeLinear,
+			percentage:   99,
+			expected:     []float64{0.99},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := aliasDeploymentWeights(testCase.strategyType, testCase.percentage)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAlarmNameFromARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn         string
+		expected    string
+		expectError bool
+	}{
+		"metric alarm": {
+			arn:      "arn:aws:cloudwatch:us-west-2:123456789012:alarm:errors", //lintignore:AWSAT003,AWSAT005
+			expected: "errors",
+		},
+		"name with colon": {
+			arn:      "arn:aws:cloudwatch:us-west-2:123456789012:alarm:app:errors", //lintignore:AWSAT003,AWSAT005
+			expected: "app:errors",
+		},
+		"not an alarm": {
+			arn:         "arn:aws:sns:us-west-2:123456789012:topic", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"invalid": {
+			arn:         "errors",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := alarmNameFromARN(testCase.arn)
+
+			if (err != nil) != testCase.expectError {
+				t.Fatalf("error = %v, expectError = %t", err, testCase.expectError)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -223,6 +226,76 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaAlias_deploymentStrategy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_deploymentStrategy(rName, "lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "deployment_strategy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_strategy.0.type", "LINEAR"),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+				),
+			},
+			{
+				Config: testAccAliasConfig_deploymentStrategy(rName, "lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaAlias_deploymentStrategyRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	alarmResourceName := "aws_cloudwatch_metric_alarm.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_deploymentStrategyAlarm(rName, "lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+					testAccCheckAliasSetAlarmState(ctx, alarmResourceName),
+				),
+			},
+			{
+				Config:      testAccAliasConfig_deploymentStrategyAlarm(rName, "lambdatest_modified.zip"),
+				ExpectError: regexache.MustCompile(`in ALARM state with 10% of traffic routed to version 2.*rolled back to version 1`),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAliasDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -316,6 +389,25 @@ func testAccCheckAliasRoutingDoesNotExistConfig(v *lambda.GetAliasOutput) resour
 	}
 }
 
+func testAccCheckAliasSetAlarmState(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		_, err := conn.SetAlarmState(ctx, &cloudwatch.SetAlarmStateInput{
+			AlarmName:   aws.String(rs.Primary.Attributes["alarm_name"]),
+			StateReason: aws.String("Acceptance test"),
+			StateValue:  cloudwatchtypes.StateValueAlarm,
+		})
+
+		return err
+	}
+}
+
 func testAccAliasImportStateIDFunc(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
@@ -452,3 +544,72 @@ resource "aws_lambda_alias" "test" {
 }
 `, funcName, aliasName))
 }
+
+func testAccAliasConfig_deploymentStrategyBase(rName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_base(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename         = "test-fixtures/%[2]s"
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs20.x"
+  source_code_hash = filebase64sha256("test-fixtures/%[2]s")
+  publish          = true
+}
+`, rName, filename))
+}
+
+func testAccAliasConfig_deploymentStrategy(rName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_deploymentStrategyBase(rName, filename),
+		fmt.Sprintf(`
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+
+  deployment_strategy {
+    type             = "LINEAR"
+    percentage       = 50
+    interval_seconds = 5
+  }
+}
+`, rName))
+}
+
+func testAccAliasConfig_deploymentStrategyAlarm(rName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccAliasConfig_deploymentStrategyBase(rName, filename),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 3600
+  statistic           = "Sum"
+  threshold           = 0
+  treat_missing_data  = "notBreaching"
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+
+  deployment_strategy {
+    type             = "CANARY"
+    percentage       = 10
+    interval_seconds = 60
+    alarm_arns       = [aws_cloudwatch_metric_alarm.test.arn]
+  }
+}
+`, rName))
+}
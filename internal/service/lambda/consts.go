@@ -43,3 +43,17 @@ func (lifecycleScope) Values() []lifecycleScope {
 		lifecycleScopeCRUD,
 	}
 }
+
+type deploymentStrategyType string
+
+const (
+	deploymentStrategyTypeCanary deploymentStrategyType = "CANARY"
+	deploymentStrategyTypeLinear deploymentStrategyType = "LINEAR"
+)
+
+func (deploymentStrategyType) Values() []deploymentStrategyType {
+	return []deploymentStrategyType{
+		deploymentStrategyTypeCanary,
+		deploymentStrategyTypeLinear,
+	}
+}
@@ -174,7 +174,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -213,7 +213,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -327,7 +327,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -338,7 +338,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -375,12 +375,35 @@ func resourceFunction() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
+				ConflictsWith:    []string{"source_dir"},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+			},
+			"source_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_file_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^0?[0-7]{3}$`), "must be an octal file mode, e.g. 0644"),
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_staging_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrTimeout: {
@@ -459,6 +482,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			sourceCodeHashFromSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -498,6 +522,19 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if _, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := expandSourceArchiveCode(ctx, d, meta, functionName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging Lambda Function (%s) source: %s", functionName, err)
+		}
+
+		input.Code.S3Bucket = code.s3Bucket
+		input.Code.S3Key = code.s3Key
+		input.Code.ZipFile = code.zipFile
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -964,6 +1001,19 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if _, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := expandSourceArchiveCode(ctx, d, meta, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "packaging Lambda Function (%s) source: %s", d.Id(), err)
+			}
+
+			input.S3Bucket = code.s3Bucket
+			input.S3Key = code.s3Key
+			input.ZipFile = code.zipFile
 		} else {
 			input.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
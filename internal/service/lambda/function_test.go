@@ -1807,6 +1807,43 @@ func TestAccLambdaFunction_s3(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceDir(rName, "lib/**"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionVersion(&conf, tflambda.FunctionVersionLatest),
+					testAccCheckFunctionSourceCodeHashAttr(&conf, resourceName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_code_hash", "source_dir", "source_excludes", "source_file_mode"},
+			},
+			{
+				Config: testAccFunctionConfig_sourceDir(rName, "*.md"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionSourceCodeHashAttr(&conf, resourceName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2402,6 +2439,17 @@ func testAccCheckSourceCodeHash(function *lambda.GetFunctionOutput, expectedHash
 	}
 }
 
+func testAccCheckFunctionSourceCodeHashAttr(function *lambda.GetFunctionOutput, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		return testAccCheckSourceCodeHash(function, rs.Primary.Attributes["source_code_hash"])(s)
+	}
+}
+
 func testAccCheckAttributeIsDateAfter(s *terraform.State, name string, key string, before time.Time) error {
 	rs, ok := s.RootModule().Resources[name]
 	if !ok {
@@ -3693,6 +3741,22 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_sourceDir(rName, exclude string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir       = "test-fixtures/lambda_source"
+  source_excludes  = [%[2]q]
+  source_file_mode = "0644"
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "index.example"
+  runtime          = "nodejs20.x"
+}
+`, rName, exclude))
+}
+
 func testAccFunctionConfig_s3Simple(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "lambda_bucket" {
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
@@ -41,6 +42,8 @@ func resourceLayerVersion() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: sourceCodeHashFromSourceDir,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -84,7 +87,7 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version"},
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -105,19 +108,19 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -134,15 +137,42 @@ func resourceLayerVersion() *schema.Resource {
 				Optional: true,
 			},
 			"source_code_hash": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_dir"},
 			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", names.AttrS3Bucket, "s3_key", "s3_object_version"},
+			},
+			"source_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_file_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^0?[0-7]{3}$`), "must be an octal file mode, e.g. 0644"),
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_staging_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -160,13 +190,28 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 	s3Bucket, bucketOk := d.GetOk(names.AttrS3Bucket)
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
+	_, hasSourceDir := d.GetOk("source_dir")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasSourceDir {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *awstypes.LayerVersionContentInput
-	if hasFilename {
+	if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+		code, err := expandSourceArchiveCode(ctx, d, meta, layerName)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging Lambda Layer (%s) source: %s", layerName, err)
+		}
+
+		layerContent = &awstypes.LayerVersionContentInput{
+			S3Bucket: code.s3Bucket,
+			S3Key:    code.s3Key,
+			ZipFile:  code.zipFile,
+		}
+	} else if hasFilename {
 		conns.GlobalMutexKV.Lock(mutexLayerKey)
 		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
 
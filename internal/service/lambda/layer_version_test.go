@@ -176,6 +176,34 @@ func TestAccLambdaLayerVersion_s3(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionConfig_sourceDir(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "code_sha256", resourceName, "source_code_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_code_hash", "source_dir", names.AttrSkipDestroy},
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_compatibleRuntimes(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lambda_layer_version.test"
@@ -409,6 +437,15 @@ resource "aws_lambda_layer_version" "test" {
 `, rName)
 }
 
+func testAccLayerVersionConfig_sourceDir(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  source_dir = "test-fixtures/lambda_source"
+  layer_name = %[1]q
+}
+`, rName)
+}
+
 func testAccLayerVersionConfig_s3(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "lambda_bucket" {
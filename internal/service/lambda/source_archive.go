@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/flex"
+	tfio "github.com/isometry/terraform-provider-faws/internal/io"
+	"github.com/isometry/terraform-provider-faws/internal/sdkv2"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// sourceArchiveDirectUploadMaxSize is the maximum size of a deployment package uploaded directly to Lambda.
+	// Larger packages must be uploaded via S3.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourceArchiveDirectUploadMaxSize = 50 * 1000 * 1000
+)
+
+var (
+	// sourceArchiveModTime is the modification time recorded for every archive entry.
+	sourceArchiveModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// sourceArchive is a byte-reproducible ZIP archive built from a source directory.
+type sourceArchive struct {
+	content []byte
+}
+
+// hash returns the base64-encoded SHA-256 hash of the archive, as used by `source_code_hash`.
+func (a *sourceArchive) hash() string {
+	sum := sha256.Sum256(a.content)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// key returns the S3 object key for the archive when staged via S3.
+func (a *sourceArchive) key(name string) string {
+	sum := sha256.Sum256(a.content)
+
+	return fmt.Sprintf("%s/%s.zip", name, hex.EncodeToString(sum[:]))
+}
+
+// buildSourceArchive builds a ZIP archive of the regular files under dir whose slash-separated relative
+// paths match none of the exclude patterns. Entries are sorted by name and have fixed modification times.
+// If fileMode is nil, entry permissions are normalized to 0755 for files executable by their owner and 0644 otherwise.
+func buildSourceArchive(dir string, excludes []string, fileMode *fs.FileMode) (*sourceArchive, error) {
+	type entry struct {
+		name string
+		path string
+		mode fs.FileMode
+	}
+	var entries []entry
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		if slices.ContainsFunc(excludes, func(pattern string) bool { return tfio.GlobMatch(pattern, name) }) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to regular files.
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		mode := fs.FileMode(0o644)
+		if fileMode != nil {
+			mode = *fileMode
+		} else if info.Mode().Perm()&0o100 != 0 {
+			mode = 0o755
+		}
+
+		entries = append(entries, entry{name: name, path: path, mode: mode})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no files found in source directory (%s)", dir)
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, e := range entries {
+		header := &zip.FileHeader{
+			Name:     e.name,
+			Method:   zip.Deflate,
+			Modified: sourceArchiveModTime,
+		}
+		header.SetMode(e.mode)
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := copyFileTo(fw, e.path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return &sourceArchive{content: buf.Bytes()}, nil
+}
+
+func copyFileTo(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+
+	return err
+}
+
+// expandSourceArchive builds the source archive described by the `source_dir`, `source_excludes` and `source_file_mode` arguments.
+func expandSourceArchive(d sdkv2.ResourceDiffer) (*sourceArchive, error) {
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir: %w", err)
+	}
+
+	var excludes []string
+	if v, ok := d.Get("source_excludes").(*schema.Set); ok && v.Len() > 0 {
+		excludes = flex.ExpandStringValueSet(v)
+	}
+
+	var fileMode *fs.FileMode
+	if v := d.Get("source_file_mode").(string); v != "" {
+		v, err := strconv.ParseUint(v, 8, 32)
+		if err != nil {
+			return nil, fmt.Errorf("parsing source_file_mode: %w", err)
+		}
+		mode := fs.FileMode(v)
+		fileMode = &mode
+	}
+
+	archive, err := buildSourceArchive(sourceDir, excludes, fileMode)
+	if err != nil {
+		return nil, fmt.Errorf("building archive from source_dir (%s): %w", sourceDir, err)
+	}
+
+	return archive, nil
+}
+
+// sourceArchiveCode is the location of a source archive's code.
+// Exactly one of zipFile and s3Bucket/s3Key is set.
+type sourceArchiveCode struct {
+	s3Bucket *string
+	s3Key    *string
+	zipFile  []byte
+}
+
+// expandSourceArchiveCode builds the source archive and, if it exceeds the direct upload limit, stages it in the `source_staging_bucket` S3 bucket.
+// The archive's hash must match the planned `source_code_hash`.
+func expandSourceArchiveCode(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) (*sourceArchiveCode, error) {
+	archive, err := expandSourceArchive(d)
+	if err != nil {
+		return nil, err
+	}
+
+	if got, want := archive.hash(), d.Get("source_code_hash").(string); want != "" && got != want {
+		return nil, fmt.Errorf("source_dir contents changed after plan: archive hash %s does not match planned source_code_hash %s", got, want)
+	}
+
+	if len(archive.content) <= sourceArchiveDirectUploadMaxSize {
+		return &sourceArchiveCode{zipFile: archive.content}, nil
+	}
+
+	bucket, ok := d.GetOk("source_staging_bucket")
+	if !ok {
+		return nil, fmt.Errorf("archive size (%d bytes) exceeds the direct upload limit (%d bytes); set source_staging_bucket", len(archive.content), sourceArchiveDirectUploadMaxSize)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	input := &s3.PutObjectInput{
+		Body:   bytes.NewReader(archive.content),
+		Bucket: aws.String(bucket.(string)),
+		Key:    aws.String(archive.key(name)),
+	}
+
+	if _, err := conn.PutObject(ctx, input); err != nil {
+		return nil, fmt.Errorf("uploading archive to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return &sourceArchiveCode{
+		s3Bucket: input.Bucket,
+		s3Key:    input.Key,
+	}, nil
+}
+
+// sourceCodeHashFromSourceDir sets `source_code_hash` to the hash of the archive built from `source_dir`.
+func sourceCodeHashFromSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source_dir", "source_excludes", "source_file_mode"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("source_code_hash")
+		}
+	}
+
+	if _, ok := d.GetOk("source_dir"); !ok {
+		return nil
+	}
+
+	archive, err := expandSourceArchive(d)
+	if err != nil {
+		return err
+	}
+
+	if hash := archive.hash(); d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
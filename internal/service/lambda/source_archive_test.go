@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBuildSourceArchive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for name, mode := range map[string]fs.FileMode{
+		"index.js":                  0o600,
+		"bin/run.sh":                0o700,
+		"lib/__pycache__/mod.pyc":   0o644,
+		"node_modules/.cache/x.bin": 0o644,
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+	excludes := []string{"__pycache__", "node_modules/.cache/**"}
+
+	archive, err := buildSourceArchive(dir, excludes, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Touching a file must not change the archive.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := buildSourceArchive(dir, excludes, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := again.hash(), archive.hash(); got != want {
+		t.Errorf("archive not reproducible: hash = %s, want %s", got, want)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive.content), int64(len(archive.content)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		name string
+		mode fs.FileMode
+	}{
+		{name: "bin/run.sh", mode: 0o755},
+		{name: "index.js", mode: 0o644},
+	}
+
+	if got, want := len(r.File), len(want); got != want {
+		t.Fatalf("archive entries = %d, want %d", got, want)
+	}
+
+	for i, f := range r.File {
+		if got, want := f.Name, want[i].name; got != want {
+			t.Errorf("entry %d name = %s, want %s", i, got, want)
+		}
+		if got, want := f.Mode().Perm(), want[i].mode; got != want {
+			t.Errorf("entry %s mode = %s, want %s", f.Name, got, want)
+		}
+		if got, want := f.Modified, sourceArchiveModTime; !got.Equal(want) {
+			t.Errorf("entry %s modified = %s, want %s", f.Name, got, want)
+		}
+	}
+
+	fileMode := fs.FileMode(0o444)
+	readOnly, err := buildSourceArchive(dir, excludes, &fileMode)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if readOnly.hash() == archive.hash() {
+		t.Errorf("archive hash unchanged by file mode")
+	}
+}
+
+func TestBuildSourceArchive_empty(t *testing.T) {
+	t.Parallel()
+
+	if _, err := buildSourceArchive(t.TempDir(), nil, nil); err == nil {
+		t.Errorf("expected error for empty source directory")
+	}
+}
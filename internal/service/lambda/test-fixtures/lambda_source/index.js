@@ -0,0 +1,5 @@
+var lib = require("./lib/greeting");
+
+exports.example = function(event, context, callback) {
+  callback(null, lib.greeting());
+};
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+	fwflex "github.com/isometry/terraform-provider-faws/internal/framework/flex"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+)
+
+const (
+	insightsQueryDefaultTimeout = 5 * time.Minute
+)
+
+var insightsQueryResultsElemType = types.MapType{ElemType: types.StringType}
+
+// insightsQueryModel is the model shared by the aws_cloudwatch_log_insights_query data source and ephemeral resource.
+type insightsQueryModel struct {
+	Duration            fwtypes.Duration                                    `tfsdk:"duration"`
+	EndTime             timetypes.RFC3339                                   `tfsdk:"end_time"`
+	Limit               types.Int64                                         `tfsdk:"limit"`
+	LogGroupNames       fwtypes.ListValueOf[types.String]                   `tfsdk:"log_group_names"`
+	QueryDefinitionName types.String                                        `tfsdk:"query_definition_name"`
+	QueryID             types.String                                        `tfsdk:"query_id"`
+	QueryString         types.String                                        `tfsdk:"query_string"`
+	Results             types.List                                          `tfsdk:"results"`
+	StartTime           timetypes.RFC3339                                   `tfsdk:"start_time"`
+	Statistics          fwtypes.ObjectValueOf[insightsQueryStatisticsModel] `tfsdk:"statistics"`
+}
+
+type insightsQueryStatisticsModel struct {
+	BytesScanned   types.Float64 `tfsdk:"bytes_scanned"`
+	RecordsMatched types.Float64 `tfsdk:"records_matched"`
+	RecordsScanned types.Float64 `tfsdk:"records_scanned"`
+}
+
+// runInsightsQuery runs the CloudWatch Logs Insights query described by data and waits for it to complete.
+func runInsightsQuery(ctx context.Context, conn *cloudwatchlogs.Client, data *insightsQueryModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	logGroupNames := fwflex.ExpandFrameworkStringValueList(ctx, data.LogGroupNames)
+	queryString := data.QueryString.ValueString()
+
+	if name := data.QueryDefinitionName.ValueString(); name != "" {
+		queryDefinition, err := findQueryDefinitionByName(ctx, conn, name)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading CloudWatch Logs Query Definition (%s)", name), err.Error())
+			return diags
+		}
+
+		queryString = aws.ToString(queryDefinition.QueryString)
+		if len(logGroupNames) == 0 {
+			logGroupNames = queryDefinition.LogGroupNames
+		}
+	}
+
+	if len(logGroupNames) == 0 {
+		diags.AddError("no log groups specified", "Specify log_group_names, or a query definition that includes log groups.")
+		return diags
+	}
+
+	startTime, endTime, d := expandInsightsQueryTimeWindow(data, time.Now())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := cloudwatchlogs.StartQueryInput{
+		EndTime:       aws.Int64(endTime.Unix()),
+		Limit:         fwflex.Int32FromFramework(ctx, data.Limit),
+		LogGroupNames: logGroupNames,
+		QueryString:   aws.String(queryString),
+		StartTime:     aws.Int64(startTime.Unix()),
+	}
+
+	// The number of concurrent queries per account is limited.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.LimitExceededException](ctx, timeout, func() (interface{}, error) {
+		return conn.StartQuery(ctx, &input)
+	})
+
+	if err != nil {
+		diags.AddError("starting CloudWatch Logs Insights query", err.Error())
+		return diags
+	}
+
+	queryID := aws.ToString(outputRaw.(*cloudwatchlogs.StartQueryOutput).QueryId)
+
+	output, err := waitQueryCompleted(ctx, conn, queryID, timeout)
+
+	if err != nil {
+		// Release the concurrent query slot.
+		if _, err := conn.StopQuery(context.WithoutCancel(ctx), &cloudwatchlogs.StopQueryInput{QueryId: aws.String(queryID)}); err != nil {
+			tflog.Debug(ctx, "stopping CloudWatch Logs Insights query", map[string]any{
+				"query_id": queryID,
+				"error":    err.Error(),
+			})
+		}
+
+		diags.AddError(fmt.Sprintf("waiting for CloudWatch Logs Insights query (%s) complete", queryID), err.Error())
+		return diags
+	}
+
+	data.LogGroupNames = fwflex.FlattenFrameworkStringValueListOfString(ctx, logGroupNames)
+	data.QueryID = types.StringValue(queryID)
+	data.QueryString = types.StringValue(queryString)
+	if data.StartTime.IsNull() {
+		data.StartTime = timetypes.NewRFC3339TimeValue(startTime.UTC())
+	}
+	if data.EndTime.IsNull() {
+		data.EndTime = timetypes.NewRFC3339TimeValue(endTime.UTC())
+	}
+
+	data.Results, d = flattenInsightsQueryResults(ctx, output.Results)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := output.Statistics; v != nil {
+		data.Statistics = fwtypes.NewObjectValueOfMust(ctx, &insightsQueryStatisticsModel{
+			BytesScanned:   types.Float64Value(v.BytesScanned),
+			RecordsMatched: types.Float64Value(v.RecordsMatched),
+			RecordsScanned: types.Float64Value(v.RecordsScanned),
+		})
+	}
+
+	return diags
+}
+
+// expandInsightsQueryTimeWindow returns the query's time window, truncated to whole seconds.
+// end_time defaults to now, and start_time may instead be specified relative to end_time using duration.
+func expandInsightsQueryTimeWindow(data *insightsQueryModel, now time.Time) (time.Time, time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endTime := now
+	if !data.EndTime.IsNull() {
+		var d diag.Diagnostics
+		endTime, d = data.EndTime.ValueRFC3339Time()
+		diags.Append(d...)
+	}
+
+	var startTime time.Time
+	if !data.Duration.IsNull() {
+		startTime = endTime.Add(-data.Duration.ValueDuration())
+	} else {
+		var d diag.Diagnostics
+		startTime, d = data.StartTime.ValueRFC3339Time()
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return startTime, endTime, diags
+	}
+
+	startTime, endTime = startTime.Truncate(time.Second), endTime.Truncate(time.Second)
+
+	if !startTime.Before(endTime) {
+		diags.AddError("invalid time window", fmt.Sprintf("start time (%s) must be before end time (%s)", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)))
+	}
+
+	return startTime, endTime, diags
+}
+
+func flattenInsightsQueryResults(ctx context.Context, apiObjects [][]awstypes.ResultField) (types.List, diag.Diagnostics) {
+	rows := make([]map[string]string, 0, len(apiObjects))
+
+	for _, fields := range apiObjects {
+		row := make(map[string]string, len(fields))
+
+		for _, v := range fields {
+			row[aws.ToString(v.Field)] = aws.ToString(v.Value)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return types.ListValueFrom(ctx, insightsQueryResultsElemType, rows)
+}
+
+func findQueryResultsByID(ctx context.Context, conn *cloudwatchlogs.Client, id string) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	input := cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryResults(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusQuery(ctx context.Context, conn *cloudwatchlogs.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueryResultsByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueryCompleted(ctx context.Context, conn *cloudwatchlogs.Client, id string, timeout time.Duration) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueryStatusScheduled, awstypes.QueryStatusRunning),
+		Target:     enum.Slice(awstypes.QueryStatusComplete),
+		Refresh:    statusQuery(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetQueryResultsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
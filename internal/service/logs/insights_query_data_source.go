@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/isometry/terraform-provider-faws/internal/framework"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+// @FrameworkDataSource("aws_cloudwatch_log_insights_query", name="Insights Query")
+func newInsightsQueryDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &insightsQueryDataSource{}, nil
+}
+
+type insightsQueryDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+type insightsQueryDataSourceModel struct {
+	insightsQueryModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *insightsQueryDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_insights_query"
+}
+
+func (d *insightsQueryDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDuration: schema.StringAttribute{
+				CustomType: fwtypes.DurationType,
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(names.AttrStartTime)),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"limit": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+			},
+			"log_group_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			"query_definition_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("query_string")),
+				},
+			},
+			"query_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query_string": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"results": schema.ListAttribute{
+				ElementType: insightsQueryResultsElemType,
+				Computed:    true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"statistics": schema.ObjectAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[insightsQueryStatisticsModel](ctx),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *insightsQueryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data insightsQueryDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, insightsQueryDefaultTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+
+	response.Diagnostics.Append(runInsightsQuery(ctx, conn, &data.insightsQueryModel, timeout)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+func TestAccLogsInsightsQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_insights_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightsQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "end_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "log_group_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "log_group_names.0", "aws_cloudwatch_log_group.test", names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStartTime),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.records_matched", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsInsightsQueryDataSource_queryDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_insights_query.test"
+	queryDefinitionResourceName := "aws_cloudwatch_query_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightsQueryDataSourceConfig_queryDefinition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "end_time", "2025-01-01T01:00:00Z"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "log_group_names.#", queryDefinitionResourceName, "log_group_names.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "log_group_names.0", queryDefinitionResourceName, "log_group_names.0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_string", queryDefinitionResourceName, "query_string"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStartTime, "2025-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInsightsQueryDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+data "aws_cloudwatch_log_insights_query" "test" {
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+  duration        = "15m"
+  limit           = 10
+
+  query_string = <<-EOT
+    fields @timestamp, @message
+    | filter @message like /ERROR/
+    | sort @timestamp desc
+  EOT
+}
+`, rName)
+}
+
+func testAccInsightsQueryDataSourceConfig_queryDefinition(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_query_definition" "test" {
+  name            = %[1]q
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+
+  query_string = <<-EOT
+    stats count(*) by bin(5m)
+  EOT
+}
+
+data "aws_cloudwatch_log_insights_query" "test" {
+  query_definition_name = aws_cloudwatch_query_definition.test.name
+  start_time            = "2025-01-01T00:00:00Z"
+  end_time              = "2025-01-01T01:00:00Z"
+}
+`, rName)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+)
+
+func TestExpandInsightsQueryTimeWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 600, time.UTC)
+
+	testCases := map[string]struct {
+		data          insightsQueryModel
+		expectedStart time.Time
+		expectedEnd   time.Time
+		expectError   bool
+	}{
+		"duration": {
+			data: insightsQueryModel{
+				Duration:  fwtypes.DurationValue("15m"),
+				EndTime:   timetypes.NewRFC3339Null(),
+				StartTime: timetypes.NewRFC3339Null(),
+			},
+			expectedStart: time.Date(2025, 1, 2, 2, 49, 5, 0, time.UTC),
+			expectedEnd:   time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		"duration and end time": {
+			data: insightsQueryModel{
+				Duration:  fwtypes.DurationValue("1h"),
+				EndTime:   timetypes.NewRFC3339ValueMust("2025-01-01T12:00:00Z"),
+				StartTime: timetypes.NewRFC3339Null(),
+			},
+			expectedStart: time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		"start time": {
+			data: insightsQueryModel{
+				Duration:  fwtypes.DurationNull(),
+				EndTime:   timetypes.NewRFC3339Null(),
+				StartTime: timetypes.NewRFC3339ValueMust("2025-01-02T00:00:00Z"),
+			},
+			expectedStart: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		"start time after end time": {
+			data: insightsQueryModel{
+				Duration:  fwtypes.DurationNull(),
+				EndTime:   timetypes.NewRFC3339ValueMust("2025-01-01T00:00:00Z"),
+				StartTime: timetypes.NewRFC3339ValueMust("2025-01-02T00:00:00Z"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotStart, gotEnd, diags := expandInsightsQueryTimeWindow(&testCase.data, now)
+
+			if diags.HasError() != testCase.expectError {
+				t.Fatalf("diags = %v, expectError = %t", diags, testCase.expectError)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if !gotStart.Equal(testCase.expectedStart) {
+				t.Errorf("start time = %s, expected %s", gotStart, testCase.expectedStart)
+			}
+
+			if !gotEnd.Equal(testCase.expectedEnd) {
+				t.Errorf("end time = %s, expected %s", gotEnd, testCase.expectedEnd)
+			}
+		})
+	}
+}
+
+func TestFlattenInsightsQueryResults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	got, diags := flattenInsightsQueryResults(ctx, [][]awstypes.ResultField{
+		{
+			{Field: aws.String("status"), Value: aws.String("503")},
+			{Field: aws.String("count"), Value: aws.String("7")},
+		},
+		{
+			{Field: aws.String("status"), Value: aws.String("500")},
+		},
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var rows []map[string]string
+	if diags := got.ElementsAs(ctx, &rows, false); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := []map[string]string{
+		{"count": "7", "status": "503"},
+		{"status": "500"},
+	}
+
+	if diff := cmp.Diff(rows, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	got, diags = flattenInsightsQueryResults(ctx, nil)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got.IsNull() || len(got.Elements()) != 0 {
+		t.Errorf("expected empty list, got %s", got)
+	}
+}
@@ -174,6 +174,16 @@ func findQueryDefinitionByTwoPartKey(ctx context.Context, conn *cloudwatchlogs.C
 	})
 }
 
+func findQueryDefinitionByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*awstypes.QueryDefinition, error) {
+	input := cloudwatchlogs.DescribeQueryDefinitionsInput{
+		QueryDefinitionNamePrefix: aws.String(name),
+	}
+
+	return findQueryDefinition(ctx, conn, &input, func(v *awstypes.QueryDefinition) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
 func findQueryDefinition(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeQueryDefinitionsInput, filter tfslices.Predicate[*awstypes.QueryDefinition]) (*awstypes.QueryDefinition, error) {
 	output, err := findQueryDefinitions(ctx, conn, input, filter)
 
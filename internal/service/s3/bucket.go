@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -703,7 +704,10 @@ func resourceBucket() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verify.SetPlanTimeValue(names.AttrARN, verify.GlobalARNNoAccountFromPlan("s3", "%s", names.AttrBucket)),
+		),
 	}
 }
 
@@ -1136,7 +1140,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrRegion, region)
 	d.Set("bucket_regional_domain_name", bucketRegionalDomainName(d.Id(), region))
 
-	hostedZoneID, err := hostedZoneIDForRegion(region)
+	hostedZoneID, err := hostedZoneIDForBucketRegion(ctx, meta.(*conns.AWSClient), region)
 	if err != nil {
 		log.Printf("[WARN] %s", err)
 	} else {
@@ -98,7 +98,7 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("bucket_domain_name", awsClient.PartitionHostname(ctx, bucket+".s3"))
 	d.Set("bucket_regional_domain_name", bucketRegionalDomainName(bucket, region))
-	if hostedZoneID, err := hostedZoneIDForRegion(region); err == nil {
+	if hostedZoneID, err := hostedZoneIDForBucketRegion(ctx, awsClient, region); err == nil {
 		d.Set(names.AttrHostedZoneID, hostedZoneID)
 	} else {
 		log.Printf("[WARN] HostedZoneIDForRegion: %s", err)
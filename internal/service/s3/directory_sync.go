@@ -0,0 +1,1046 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/isometry/terraform-provider-faws/internal/errs/fwdiag"
+	"github.com/isometry/terraform-provider-faws/internal/framework"
+	fwflex "github.com/isometry/terraform-provider-faws/internal/framework/flex"
+	fwtypes "github.com/isometry/terraform-provider-faws/internal/framework/types"
+	tfio "github.com/isometry/terraform-provider-faws/internal/io"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncDefaultConcurrency = 10
+	directorySyncDefaultContentType = "application/octet-stream"
+	// User-defined metadata key holding the SHA-256 of the uploaded content.
+	// Unlike the ETag, it doesn't depend on how the object was uploaded or encrypted.
+	directorySyncContentHashMetadataKey = "content-sha256"
+	// Private state key holding the listing entry and digest of each managed object as last synchronized or read.
+	directorySyncObjectsPrivateStateKey = "objects"
+)
+
+// @FrameworkResource("aws_s3_directory_sync", name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type directorySyncResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *directorySyncResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_sync"
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(directorySyncDefaultConcurrency),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"delete_orphans": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"exclude": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"file_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"include": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest_digest": schema.StringAttribute{
+				Computed: true,
+			},
+			"source_dir": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"file_rule": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[directorySyncFileRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cache_control": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrContentType: schema.StringAttribute{
+							Optional: true,
+						},
+						"metadata": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"pattern": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, err := data.manifest(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source_dir"), "building S3 Directory Sync manifest", err.Error())
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	objects, err := r.sync(ctx, &data, manifest, nil)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("synchronizing S3 Directory Sync (%s)", data.SourceDir.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.FileCount = types.Int64Value(int64(len(manifest.files)))
+	data.ManifestDigest = types.StringValue(manifest.digest())
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	response.Diagnostics.Append(setDirectorySyncObjects(ctx, response.Private, objects)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	conn := r.conn(ctx, bucket)
+
+	scope, err := data.scope(ctx)
+
+	if err != nil {
+		// Without the local manifest the managed objects can't be determined, so leave the state unchanged.
+		tflog.Warn(ctx, "unable to determine S3 Directory Sync managed objects", map[string]any{
+			"error": err.Error(),
+		})
+		response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+
+		return
+	}
+
+	listing, err := findDirectorySyncListing(ctx, conn, bucket, keyPrefix)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	previous, diags := getDirectorySyncObjects(ctx, request.Private)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	filter := data.filter(ctx)
+	maps.DeleteFunc(listing, func(key string, _ directorySyncObject) bool {
+		return !filter.matches(strings.TrimPrefix(key, keyPrefix)) || !scope(key)
+	})
+
+	remote, err := findDirectorySyncObjects(ctx, conn, bucket, listing, previous, int(data.Concurrency.ValueInt64()))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Any object that is missing or differs from the local file changes the digest, which ModifyPlan sets from the local files.
+	data.FileCount = types.Int64Value(int64(len(remote)))
+	data.ManifestDigest = types.StringValue(remote.digest())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	response.Diagnostics.Append(setDirectorySyncObjects(ctx, response.Private, remote)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	manifest, err := new.manifest(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source_dir"), "building S3 Directory Sync manifest", err.Error())
+
+		return
+	}
+
+	previous, diags := getDirectorySyncObjects(ctx, request.Private)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.UpdateTimeout(ctx, new.Timeouts))
+	defer cancel()
+
+	objects, err := r.sync(ctx, &new, manifest, previous)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("synchronizing S3 Directory Sync (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.FileCount = types.Int64Value(int64(len(manifest.files)))
+	new.ManifestDigest = types.StringValue(manifest.digest())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+	response.Diagnostics.Append(setDirectorySyncObjects(ctx, response.Private, objects)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	conn := r.conn(ctx, bucket)
+
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	listing, err := findDirectorySyncListing(ctx, conn, bucket, keyPrefix)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	filter := data.filter(ctx)
+	var keys []string
+
+	if data.DeleteOrphans.ValueBool() {
+		// The resource is authoritative for every in-scope object under the prefix.
+		for key := range listing {
+			if filter.matches(strings.TrimPrefix(key, keyPrefix)) {
+				keys = append(keys, key)
+			}
+		}
+	} else {
+		manifest, err := data.manifest(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddWarning(
+				fmt.Sprintf("S3 Directory Sync (%s) objects not deleted", data.ID.ValueString()),
+				fmt.Sprintf("building manifest from source directory: %s. Uploaded objects have been left in place.", err),
+			)
+
+			return
+		}
+
+		for key := range listing {
+			if manifest.contains(key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	if err := deleteObjectsByKey(ctx, conn, bucket, keys); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Sync (%s) objects", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.isKnown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("file_count"), types.Int64Unknown())...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("manifest_digest"), types.StringUnknown())...)
+
+		return
+	}
+
+	manifest, err := data.manifest(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source_dir"), "building S3 Directory Sync manifest", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("file_count"), types.Int64Value(int64(len(manifest.files))))...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("manifest_digest"), types.StringValue(manifest.digest()))...)
+}
+
+func (r *directorySyncResource) conn(ctx context.Context, bucket string) *s3.Client {
+	if isDirectoryBucket(bucket) {
+		return r.Meta().S3ExpressClient(ctx)
+	}
+
+	return r.Meta().S3Client(ctx)
+}
+
+// sync uploads new and changed files and, if configured, deletes orphaned objects.
+// previous holds the objects recorded by the last synchronization or read, if any.
+// It returns the objects for the files in the manifest.
+func (r *directorySyncResource) sync(ctx context.Context, data *directorySyncResourceModel, manifest *directorySyncManifest, previous directorySyncObjects) (directorySyncObjects, error) {
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	concurrency := int(data.Concurrency.ValueInt64())
+	conn := r.conn(ctx, bucket)
+
+	listing, err := findDirectorySyncListing(ctx, conn, bucket, keyPrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	managed := make(map[string]directorySyncObject)
+	for key, v := range listing {
+		if manifest.contains(key) {
+			managed[key] = v
+		}
+	}
+
+	remote, err := findDirectorySyncObjects(ctx, conn, bucket, managed, previous, concurrency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var toUpload []directorySyncFile
+	for _, file := range manifest.files {
+		if v, ok := remote[file.key]; !ok || v.Digest != file.digest() {
+			toUpload = append(toUpload, file)
+		}
+	}
+
+	tflog.Debug(ctx, "uploading S3 Directory Sync files", map[string]any{
+		"source_dir": data.SourceDir.ValueString(),
+		"upload":     len(toUpload),
+		"total":      len(manifest.files),
+	})
+	uploader := manager.NewUploader(conn)
+	if err := uploadDirectorySyncFiles(ctx, uploader, bucket, toUpload, concurrency); err != nil {
+		return nil, err
+	}
+
+	objects := remote
+	if len(toUpload) > 0 {
+		// Record the listing entries of the uploaded objects.
+		uploaded, err := findDirectorySyncListing(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range toUpload {
+			if v, ok := uploaded[file.key]; ok {
+				v.Digest = file.digest()
+				objects[file.key] = v
+			} else {
+				delete(objects, file.key)
+			}
+		}
+	}
+
+	if !data.DeleteOrphans.ValueBool() {
+		return objects, nil
+	}
+
+	filter := data.filter(ctx)
+	var orphans []string
+	for key := range listing {
+		if manifest.contains(key) {
+			continue
+		}
+		// Objects outside the include/exclude scope are not managed.
+		if filter.matches(strings.TrimPrefix(key, keyPrefix)) {
+			orphans = append(orphans, key)
+		}
+	}
+
+	tflog.Debug(ctx, "deleting S3 Directory Sync orphaned objects", map[string]any{
+		"source_dir": data.SourceDir.ValueString(),
+		"delete":     len(orphans),
+	})
+	if err := deleteObjectsByKey(ctx, conn, bucket, orphans); err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// uploadDirectorySyncFiles uploads the specified files using at most `concurrency` concurrent uploads.
+func uploadDirectorySyncFiles(ctx context.Context, uploader *manager.Uploader, bucket string, files []directorySyncFile, concurrency int) error {
+	return forEachDirectorySyncItem(ctx, files, concurrency, func(file directorySyncFile) error {
+		return uploadDirectorySyncFile(ctx, uploader, bucket, file)
+	})
+}
+
+// forEachDirectorySyncItem calls f for each item using at most `concurrency` concurrent goroutines.
+func forEachDirectorySyncItem[T any](ctx context.Context, items []T, concurrency int, f func(T) error) error {
+	var (
+		errs []error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	semaphore := make(chan struct{}, max(concurrency, 1))
+
+	for _, item := range items {
+		if ctx.Err() != nil {
+			break
+		}
+
+		semaphore <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := f(item); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *manager.Uploader, bucket string, file directorySyncFile) error {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("opening (%s): %w", file.path, err)
+	}
+	defer body.Close()
+
+	metadata := maps.Clone(file.metadata)
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	metadata[directorySyncContentHashMetadataKey] = file.contentHash
+
+	input := &s3.PutObjectInput{
+		Body:        body,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(file.contentType),
+		Key:         aws.String(file.key),
+		Metadata:    metadata,
+	}
+
+	if file.cacheControl != "" {
+		input.CacheControl = aws.String(file.cacheControl)
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", file.key, bucket, err)
+	}
+
+	return nil
+}
+
+// findDirectorySyncListing returns the listing entries, by key, of all objects under the specified prefix.
+func findDirectorySyncListing(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]directorySyncObject, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket:       aws.String(bucket),
+		EncodingType: awstypes.EncodingTypeUrl,
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	output := make(map[string]directorySyncObject)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range page.Contents {
+			// Reverse URL-encoding from requested EncodingType: "url"
+			key, err := url.QueryUnescape(aws.ToString(v.Key))
+			if err != nil {
+				return nil, fmt.Errorf("listing S3 Bucket (%s) objects: unescaping object key: %w", bucket, err)
+			}
+			output[key] = directorySyncObject{
+				ETag:         aws.ToString(v.ETag),
+				LastModified: aws.ToTime(v.LastModified),
+				Size:         aws.ToInt64(v.Size),
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// findDirectorySyncObjects returns the specified listed objects with their digests.
+// The digest recorded in previous is reused if an object's listing entry is unchanged,
+// otherwise the object's headers are read, using at most `concurrency` concurrent requests.
+// Objects deleted since being listed are omitted.
+func findDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket string, listing map[string]directorySyncObject, previous directorySyncObjects, concurrency int) (directorySyncObjects, error) {
+	objects := make(directorySyncObjects, len(listing))
+	var changed []string
+
+	for key, v := range listing {
+		if p, ok := previous[key]; ok && p.unchanged(v) {
+			v.Digest = p.Digest
+			objects[key] = v
+		} else {
+			changed = append(changed, key)
+		}
+	}
+
+	var mu sync.Mutex
+	err := forEachDirectorySyncItem(ctx, changed, concurrency, func(key string) error {
+		file, err := findDirectorySyncFile(ctx, conn, bucket, key)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		v := listing[key]
+		v.Digest = file.digest()
+
+		mu.Lock()
+		objects[key] = v
+		mu.Unlock()
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// findDirectorySyncFile returns the content hash and headers of the specified object.
+func findDirectorySyncFile(ctx context.Context, conn *s3.Client, bucket, key string) (directorySyncFile, error) {
+	output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+	if tfresource.NotFound(err) {
+		return directorySyncFile{}, err
+	}
+
+	if err != nil {
+		return directorySyncFile{}, fmt.Errorf("reading S3 Object (%s) in Bucket (%s): %w", key, bucket, err)
+	}
+
+	file := directorySyncFile{
+		cacheControl: aws.ToString(output.CacheControl),
+		contentType:  aws.ToString(output.ContentType),
+		key:          key,
+	}
+	for k, v := range output.Metadata {
+		if k = strings.ToLower(k); k == directorySyncContentHashMetadataKey {
+			file.contentHash = v
+			continue
+		}
+		if file.metadata == nil {
+			file.metadata = make(map[string]string)
+		}
+		file.metadata[k] = v
+	}
+
+	return file, nil
+}
+
+// deleteObjectsByKey deletes the specified objects in pages of at most 1000 keys.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	slices.Sort(keys)
+
+	for chunk := range slices.Chunk(keys, 1000) {
+		toDelete := make([]awstypes.ObjectIdentifier, 0, len(chunk))
+		for _, key := range chunk {
+			toDelete = append(toDelete, awstypes.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		if _, err := deletePage(ctx, conn, bucket, false, toDelete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type directorySyncResourceModel struct {
+	Bucket         types.String                                                `tfsdk:"bucket"`
+	Concurrency    types.Int64                                                 `tfsdk:"concurrency"`
+	DeleteOrphans  types.Bool                                                  `tfsdk:"delete_orphans"`
+	Exclude        fwtypes.SetValueOf[types.String]                            `tfsdk:"exclude"`
+	FileCount      types.Int64                                                 `tfsdk:"file_count"`
+	FileRules      fwtypes.ListNestedObjectValueOf[directorySyncFileRuleModel] `tfsdk:"file_rule"`
+	ID             types.String                                                `tfsdk:"id"`
+	Include        fwtypes.SetValueOf[types.String]                            `tfsdk:"include"`
+	KeyPrefix      types.String                                                `tfsdk:"key_prefix"`
+	ManifestDigest types.String                                                `tfsdk:"manifest_digest"`
+	SourceDir      types.String                                                `tfsdk:"source_dir"`
+	Timeouts       timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+type directorySyncFileRuleModel struct {
+	CacheControl types.String                     `tfsdk:"cache_control"`
+	ContentType  types.String                     `tfsdk:"content_type"`
+	Metadata     fwtypes.MapValueOf[types.String] `tfsdk:"metadata"`
+	Pattern      types.String                     `tfsdk:"pattern"`
+}
+
+func (data *directorySyncResourceModel) setID() {
+	data.ID = types.StringValue(data.Bucket.ValueString() + "/" + data.KeyPrefix.ValueString())
+}
+
+// isKnown returns whether all the values that contribute to the manifest are known.
+func (data *directorySyncResourceModel) isKnown() bool {
+	for _, v := range []interface{ IsUnknown() bool }{data.Exclude, data.FileRules, data.Include, data.KeyPrefix, data.SourceDir} {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (data *directorySyncResourceModel) filter(ctx context.Context) directorySyncFilter {
+	return directorySyncFilter{
+		exclude: fwflex.ExpandFrameworkStringValueSet(ctx, data.Exclude),
+		include: fwflex.ExpandFrameworkStringValueSet(ctx, data.Include),
+	}
+}
+
+// scope returns a function reporting whether an in-scope object is managed by the resource.
+// With delete_orphans every in-scope object is managed, otherwise only the objects for the files in the local manifest.
+func (data *directorySyncResourceModel) scope(ctx context.Context) (func(string) bool, error) {
+	if data.DeleteOrphans.ValueBool() {
+		return func(string) bool { return true }, nil
+	}
+
+	manifest, err := data.manifest(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.contains, nil
+}
+
+func (data *directorySyncResourceModel) manifest(ctx context.Context) (*directorySyncManifest, error) {
+	var rules []directorySyncFileRule
+
+	fileRules, diags := data.FileRules.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, errors.New("reading file rules")
+	}
+
+	for _, v := range fileRules {
+		rules = append(rules, directorySyncFileRule{
+			cacheControl: v.CacheControl.ValueString(),
+			contentType:  v.ContentType.ValueString(),
+			metadata:     fwflex.ExpandFrameworkStringValueMap(ctx, v.Metadata),
+			pattern:      v.Pattern.ValueString(),
+		})
+	}
+
+	sourceDir, err := homedir.Expand(data.SourceDir.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", data.SourceDir.ValueString(), err)
+	}
+
+	return buildDirectorySyncManifest(sourceDir, data.KeyPrefix.ValueString(), data.filter(ctx), rules)
+}
+
+// directorySyncFilter selects the files (by slash-separated path relative to the source directory) to be synchronized.
+type directorySyncFilter struct {
+	exclude []string
+	include []string
+}
+
+func (f directorySyncFilter) matches(name string) bool {
+	if len(f.include) > 0 && !slices.ContainsFunc(f.include, func(pattern string) bool { return tfio.GlobMatch(pattern, name) }) {
+		return false
+	}
+
+	return !slices.ContainsFunc(f.exclude, func(pattern string) bool { return tfio.GlobMatch(pattern, name) })
+}
+
+// directorySyncFileRule sets object headers for files matching a pattern.
+type directorySyncFileRule struct {
+	cacheControl string
+	contentType  string
+	metadata     map[string]string
+	pattern      string
+}
+
+// directorySyncFile describes a single local file and the object it is uploaded to.
+type directorySyncFile struct {
+	cacheControl string
+	contentHash  string // The SHA-256 of the file's content.
+	contentType  string
+	key          string
+	metadata     map[string]string
+	path         string
+}
+
+// digest returns a SHA-256 digest over the file's content hash and headers.
+func (f directorySyncFile) digest() string {
+	h := sha256.New()
+
+	fmt.Fprintf(h, "%s\x00%s\x00%s", f.contentHash, f.contentType, f.cacheControl)
+	for _, k := range slices.Sorted(maps.Keys(f.metadata)) {
+		fmt.Fprintf(h, "\x00%s=%s", k, f.metadata[k])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// directorySyncObject is an object's listing entry and the digest of its content hash and headers.
+type directorySyncObject struct {
+	Digest       string    `json:"digest"`
+	ETag         string    `json:"etag"`
+	LastModified time.Time `json:"last_modified"`
+	Size         int64     `json:"size"`
+}
+
+// unchanged returns whether the listing entry is the same as when the object was recorded.
+func (o directorySyncObject) unchanged(listed directorySyncObject) bool {
+	return o.Digest != "" && o.ETag == listed.ETag && o.LastModified.Equal(listed.LastModified) && o.Size == listed.Size
+}
+
+// directorySyncObjects are the managed objects, by key.
+type directorySyncObjects map[string]directorySyncObject
+
+// digest returns a SHA-256 digest over every object key and its digest.
+func (o directorySyncObjects) digest() string {
+	h := sha256.New()
+
+	for _, key := range slices.Sorted(maps.Keys(o)) {
+		fmt.Fprintf(h, "%s\x00%s\n", key, o[key].Digest)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func getDirectorySyncObjects(ctx context.Context, private interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+}) (directorySyncObjects, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, d := private.GetKey(ctx, directorySyncObjectsPrivateStateKey)
+	diags.Append(d...)
+	if diags.HasError() || len(v) == 0 {
+		return nil, diags
+	}
+
+	var objects directorySyncObjects
+	if err := json.Unmarshal(v, &objects); err != nil {
+		// Without the records every object's headers are read again.
+		tflog.Warn(ctx, "unable to decode S3 Directory Sync objects from private state", map[string]any{
+			"error": err.Error(),
+		})
+
+		return nil, diags
+	}
+
+	return objects, diags
+}
+
+func setDirectorySyncObjects(ctx context.Context, private interface {
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}, objects directorySyncObjects) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := json.Marshal(objects)
+	if err != nil {
+		diags.AddError("encoding S3 Directory Sync objects", err.Error())
+
+		return diags
+	}
+
+	return private.SetKey(ctx, directorySyncObjectsPrivateStateKey, v)
+}
+
+type directorySyncManifest struct {
+	files []directorySyncFile // Sorted by key.
+}
+
+func (m *directorySyncManifest) contains(key string) bool {
+	_, ok := m.find(key)
+
+	return ok
+}
+
+func (m *directorySyncManifest) find(key string) (directorySyncFile, bool) {
+	i, ok := slices.BinarySearchFunc(m.files, key, func(v directorySyncFile, key string) int {
+		return strings.Compare(v.key, key)
+	})
+
+	if !ok {
+		return directorySyncFile{}, false
+	}
+
+	return m.files[i], true
+}
+
+func (m *directorySyncManifest) sort() {
+	slices.SortFunc(m.files, func(a, b directorySyncFile) int {
+		return strings.Compare(a.key, b.key)
+	})
+}
+
+// digest returns the digest of the objects the files are uploaded to.
+func (m *directorySyncManifest) digest() string {
+	objects := make(directorySyncObjects, len(m.files))
+	for _, file := range m.files {
+		objects[file.key] = directorySyncObject{Digest: file.digest()}
+	}
+
+	return objects.digest()
+}
+
+func buildDirectorySyncManifest(sourceDir, keyPrefix string, filter directorySyncFilter, rules []directorySyncFileRule) (*directorySyncManifest, error) {
+	manifest := &directorySyncManifest{}
+
+	err := filepath.WalkDir(sourceDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to regular files.
+		info, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		if !filter.matches(name) {
+			return nil
+		}
+
+		contentHash, err := fileContentHash(p)
+		if err != nil {
+			return err
+		}
+
+		file := directorySyncFile{
+			contentHash: contentHash,
+			contentType: directorySyncDefaultContentType,
+			key:         keyPrefix + name,
+			path:        p,
+		}
+		if v := mime.TypeByExtension(filepath.Ext(name)); v != "" {
+			file.contentType = v
+		}
+
+		// Rules are applied in order, later matching rules taking precedence.
+		for _, rule := range rules {
+			if !tfio.GlobMatch(rule.pattern, name) {
+				continue
+			}
+			if rule.cacheControl != "" {
+				file.cacheControl = rule.cacheControl
+			}
+			if rule.contentType != "" {
+				file.contentType = rule.contentType
+			}
+			for k, v := range rule.metadata {
+				// S3 returns user-defined metadata keys in lower case.
+				if k = strings.ToLower(k); k == directorySyncContentHashMetadataKey {
+					continue
+				}
+				if file.metadata == nil {
+					file.metadata = make(map[string]string)
+				}
+				file.metadata[k] = v
+			}
+		}
+
+		manifest.files = append(manifest.files, file)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	manifest.sort()
+
+	return manifest, nil
+}
+
+// fileContentHash returns the hex-encoded SHA-256 of the file's content.
+func fileContentHash(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	tfs3 "github.com/isometry/terraform-provider-faws/internal/service/s3"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":     "<html></html>",
+		"css/site.css":   "body {}",
+		"tmp/ignore.tmp": "ignored",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx, "index.html", "css/site.css"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "index.html"),
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "delete_orphans", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_digest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+	var digest string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx, "index.html"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_count", "2"),
+					resource.TestCheckResourceAttrWith(resourceName, "manifest_digest", func(v string) error {
+						digest = v
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "index.html"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "file_count", "1"),
+					resource.TestCheckResourceAttrWith(resourceName, "manifest_digest", func(v string) error {
+						if v == digest {
+							return fmt.Errorf("manifest_digest not updated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncSourceDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func testAccCheckDirectorySyncObjectExists(ctx context.Context, n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"]+name, "", "")
+
+		return err
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		key := rs.Primary.Attributes["key_prefix"] + name
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object %s still exists", key)
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context, files ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			for _, name := range files {
+				key := rs.Primary.Attributes["key_prefix"] + name
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Object %s still exists", key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+  exclude    = ["*.tmp"]
+
+  file_rule {
+    pattern       = "*.html"
+    cache_control = "no-cache"
+  }
+
+  file_rule {
+    pattern       = "css/**"
+    cache_control = "max-age=3600"
+
+    metadata = {
+      "source" = "terraform"
+    }
+  }
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncConfig_deleteOrphans(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source_dir     = %[1]q
+  delete_orphans = true
+}
+`, sourceDir))
+}
@@ -26,6 +26,7 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectorySync                           = newDirectorySyncResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
 	BucketUpdateTags                      = bucketUpdateTags
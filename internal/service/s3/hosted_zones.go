@@ -4,9 +4,11 @@
 package s3
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
 )
 
 // See https://docs.aws.amazon.com/general/latest/gr/s3.html#s3_website_region_endpoints.
@@ -57,3 +59,13 @@ func hostedZoneIDForRegion(region string) (string, error) {
 	}
 	return "", fmt.Errorf("S3 website Route 53 hosted zone ID not found for Region (%s)", region)
 }
+
+// hostedZoneIDForBucketRegion returns the Route 53 hosted zone ID for an S3 website endpoint Region,
+// preferring any hosted zone ID defined by the provider's custom partition.
+func hostedZoneIDForBucketRegion(ctx context.Context, awsClient *conns.AWSClient, region string) (string, error) {
+	if v, ok := awsClient.CustomPartitionHostedZoneID(ctx, region, conns.HostedZoneIDKeyS3Website); ok {
+		return v, nil
+	}
+
+	return hostedZoneIDForRegion(region)
+}
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+)
+
+// PreflightQuota maps a resource type to a service quota consumed by creating resources of that type.
+type PreflightQuota struct {
+	ServiceCode string
+	QuotaCode   string
+	// Amount returns the quota consumed by a planned resource. If nil, each resource consumes one unit.
+	Amount func(planned cty.Value) float64
+	// Reserved is the part of the quota that can't be consumed by resources, e.g. the concurrency that Lambda keeps unreserved.
+	Reserved float64
+	// Usage returns the current usage and, optionally, the quota value for quotas without a CloudWatch usage metric.
+	// A zero quota value is looked up in Service Quotas.
+	Usage func(ctx context.Context, client *conns.AWSClient) (float64, float64, error)
+}
+
+func (q PreflightQuota) key() string {
+	return q.ServiceCode + "/" + q.QuotaCode
+}
+
+var (
+	preflightQuotasLock sync.RWMutex
+	preflightQuotas     = map[string][]PreflightQuota{
+		"aws_eip": {{
+			ServiceCode: "ec2",
+			QuotaCode:   "L-0263D0A3", // EC2-VPC Elastic IPs.
+			Usage:       elasticIPUsage,
+		}},
+		"aws_iam_role": {{
+			ServiceCode: "iam",
+			QuotaCode:   "L-FE177D64", // Roles per account.
+			Usage:       iamRoleUsage,
+		}},
+		"aws_lambda_function": {{
+			ServiceCode: "lambda",
+			QuotaCode:   "L-B99A9384", // Concurrent executions.
+			Amount:      lambdaReservedConcurrency,
+			Reserved:    100, // Concurrency that functions can't reserve.
+			Usage:       lambdaConcurrencyUsage,
+		}},
+		"aws_vpc": {{
+			ServiceCode: "vpc",
+			QuotaCode:   "L-F678F1CE", // VPCs per Region.
+			Usage:       vpcUsage,
+		}},
+	}
+)
+
+// RegisterPreflightQuota adds a quota to the mapping table used by preflight checks.
+func RegisterPreflightQuota(resourceType string, quota PreflightQuota) {
+	preflightQuotasLock.Lock()
+	defer preflightQuotasLock.Unlock()
+
+	preflightQuotas[resourceType] = append(preflightQuotas[resourceType], quota)
+}
+
+func preflightQuotasFor(resourceType string, config *conns.ServiceQuotaPreflightConfig) []PreflightQuota {
+	preflightQuotasLock.RLock()
+	quotas := append([]PreflightQuota{}, preflightQuotas[resourceType]...)
+	preflightQuotasLock.RUnlock()
+
+	for _, v := range config.Quotas {
+		if v.ResourceType == resourceType {
+			quotas = append(quotas, PreflightQuota{
+				ServiceCode: v.ServiceCode,
+				QuotaCode:   v.QuotaCode,
+			})
+		}
+	}
+
+	return quotas
+}
+
+// Preflight accumulates the quota consumed by the resource creations planned by a provider instance.
+type Preflight struct {
+	lock    sync.Mutex
+	planned map[string]float64
+	limits  map[string]*preflightLimit
+}
+
+type preflightLimit struct {
+	name     string
+	quota    float64
+	reserved float64
+	used     float64
+	// Usage or quota could not be determined.
+	unknown bool
+}
+
+func NewPreflight() *Preflight {
+	return &Preflight{
+		planned: make(map[string]float64),
+		limits:  make(map[string]*preflightLimit),
+	}
+}
+
+// Check records the planned creation of a resource and returns a message for each quota that the planned creations would exceed.
+// Quotas whose value or usage can't be determined are skipped.
+func (p *Preflight) Check(ctx context.Context, client *conns.AWSClient, resourceType string, planned cty.Value) []string {
+	config := client.ServiceQuotaPreflightConfig(ctx)
+	if config == nil {
+		return nil
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var messages []string
+
+	for _, quota := range preflightQuotasFor(resourceType, config) {
+		amount := float64(1)
+		if quota.Amount != nil {
+			amount = quota.Amount(planned)
+		}
+
+		if amount <= 0 {
+			continue
+		}
+
+		key := quota.key()
+		p.planned[key] += amount
+
+		limit, ok := p.limits[key]
+		if !ok {
+			limit = findPreflightLimit(ctx, client, quota)
+			p.limits[key] = limit
+		}
+
+		if limit.unknown {
+			continue
+		}
+
+		if planned := p.planned[key]; limit.used+planned > limit.quota-limit.reserved {
+			message := fmt.Sprintf("Creating this %s brings the planned use of service quota %q (%s) to %g, but current usage is %g of the applied quota value %g", resourceType, limit.name, key, planned, limit.used, limit.quota)
+			if limit.reserved > 0 {
+				message += fmt.Sprintf(", of which %g can't be used", limit.reserved)
+			}
+			messages = append(messages, message+".")
+		}
+	}
+
+	return messages
+}
+
+func findPreflightLimit(ctx context.Context, client *conns.AWSClient, quota PreflightQuota) *preflightLimit {
+	limit := &preflightLimit{
+		name:     quota.QuotaCode,
+		reserved: quota.Reserved,
+	}
+	logFields := map[string]any{
+		"service_code": quota.ServiceCode,
+		"quota_code":   quota.QuotaCode,
+	}
+
+	var used, value float64
+	var err error
+	if quota.Usage != nil {
+		used, value, err = quota.Usage(ctx, client)
+
+		if err != nil {
+			logFields["error"] = err.Error()
+			tflog.Warn(ctx, "skipping Service Quotas preflight check: reading usage", logFields)
+			limit.unknown = true
+			return limit
+		}
+	}
+
+	serviceQuota, err := findAppliedOrDefaultServiceQuota(ctx, client, quota.ServiceCode, quota.QuotaCode)
+
+	switch {
+	case err == nil:
+		limit.name = aws.ToString(serviceQuota.QuotaName)
+		if value == 0 {
+			value = aws.ToFloat64(serviceQuota.Value)
+		}
+	case value == 0:
+		logFields["error"] = err.Error()
+		tflog.Warn(ctx, "skipping Service Quotas preflight check: reading quota", logFields)
+		limit.unknown = true
+		return limit
+	}
+
+	if quota.Usage == nil {
+		if serviceQuota == nil || serviceQuota.UsageMetric == nil {
+			tflog.Warn(ctx, "skipping Service Quotas preflight check: quota has no usage metric", logFields)
+			limit.unknown = true
+			return limit
+		}
+
+		used, err = findUsageMetricValue(ctx, client.CloudWatchClient(ctx), serviceQuota.UsageMetric)
+
+		if err != nil {
+			logFields["error"] = err.Error()
+			tflog.Warn(ctx, "skipping Service Quotas preflight check: reading usage metric", logFields)
+			limit.unknown = true
+			return limit
+		}
+	}
+
+	limit.quota = value
+	limit.used = used
+
+	return limit
+}
+
+func findAppliedOrDefaultServiceQuota(ctx context.Context, client *conns.AWSClient, serviceCode, quotaCode string) (*types.ServiceQuota, error) {
+	conn := client.ServiceQuotasClient(ctx)
+
+	quota, err := findServiceQuotaByID(ctx, conn, serviceCode, quotaCode)
+
+	if tfresource.NotFound(err) {
+		return findServiceQuotaDefaultByID(ctx, conn, serviceCode, quotaCode)
+	}
+
+	return quota, err
+}
+
+// findUsageMetricValue returns the most recent value of a quota's CloudWatch usage metric, or 0 if there are no recent data points.
+func findUsageMetricValue(ctx context.Context, conn *cloudwatch.Client, usageMetric *types.MetricInfo) (float64, error) {
+	statistic := cloudwatchtypes.StatisticMaximum
+	if v := aws.ToString(usageMetric.MetricStatisticRecommendation); v != "" {
+		statistic = cloudwatchtypes.Statistic(v)
+	}
+
+	var dimensions []cloudwatchtypes.Dimension
+	for k, v := range usageMetric.MetricDimensions {
+		dimensions = append(dimensions, cloudwatchtypes.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	now := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		Dimensions: dimensions,
+		EndTime:    aws.Time(now),
+		MetricName: usageMetric.MetricName,
+		Namespace:  usageMetric.MetricNamespace,
+		Period:     aws.Int32(int32((5 * time.Minute).Seconds())),
+		StartTime:  aws.Time(now.Add(-1 * time.Hour)),
+		Statistics: []cloudwatchtypes.Statistic{statistic},
+	}
+
+	output, err := conn.GetMetricStatistics(ctx, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var latest *cloudwatchtypes.Datapoint
+	for _, v := range output.Datapoints {
+		if latest == nil || aws.ToTime(v.Timestamp).After(aws.ToTime(latest.Timestamp)) {
+			latest = &v
+		}
+	}
+
+	if latest == nil {
+		return 0, nil
+	}
+
+	switch statistic {
+	case cloudwatchtypes.StatisticAverage:
+		return aws.ToFloat64(latest.Average), nil
+	case cloudwatchtypes.StatisticMinimum:
+		return aws.ToFloat64(latest.Minimum), nil
+	case cloudwatchtypes.StatisticSampleCount:
+		return aws.ToFloat64(latest.SampleCount), nil
+	case cloudwatchtypes.StatisticSum:
+		return aws.ToFloat64(latest.Sum), nil
+	default:
+		return aws.ToFloat64(latest.Maximum), nil
+	}
+}
+
+func elasticIPUsage(ctx context.Context, client *conns.AWSClient) (float64, float64, error) {
+	input := &ec2.DescribeAddressesInput{
+		Filters: []ec2types.Filter{{
+			Name:   aws.String("domain"),
+			Values: []string{string(ec2types.DomainTypeVpc)},
+		}},
+	}
+
+	output, err := client.EC2Client(ctx).DescribeAddresses(ctx, input)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return float64(len(output.Addresses)), 0, nil
+}
+
+func iamRoleUsage(ctx context.Context, client *conns.AWSClient) (float64, float64, error) {
+	output, err := client.IAMClient(ctx).GetAccountSummary(ctx, &iam.GetAccountSummaryInput{})
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// IAM reports its own quota, which is available in all Regions.
+	return float64(output.SummaryMap["Roles"]), float64(output.SummaryMap["RolesQuota"]), nil
+}
+
+func lambdaReservedConcurrency(planned cty.Value) float64 {
+	if planned.IsNull() || !planned.IsKnown() || !planned.Type().IsObjectType() || !planned.Type().HasAttribute("reserved_concurrent_executions") {
+		return 0
+	}
+
+	v := planned.GetAttr("reserved_concurrent_executions")
+	if !v.IsKnown() || v.IsNull() {
+		return 0
+	}
+
+	f, _ := v.AsBigFloat().Float64()
+
+	return max(f, 0)
+}
+
+func lambdaConcurrencyUsage(ctx context.Context, client *conns.AWSClient) (float64, float64, error) {
+	output, err := client.LambdaClient(ctx).GetAccountSettings(ctx, &lambda.GetAccountSettingsInput{})
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if output.AccountLimit == nil {
+		return 0, 0, tfresource.NewEmptyResultError(nil)
+	}
+
+	reserved := float64(output.AccountLimit.ConcurrentExecutions - aws.ToInt32(output.AccountLimit.UnreservedConcurrentExecutions))
+
+	return reserved, float64(output.AccountLimit.ConcurrentExecutions), nil
+}
+
+func vpcUsage(ctx context.Context, client *conns.AWSClient) (float64, float64, error) {
+	var n int
+
+	pages := ec2.NewDescribeVpcsPaginator(client.EC2Client(ctx), &ec2.DescribeVpcsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		n += len(page.Vpcs)
+	}
+
+	return float64(n), 0, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+)
+
+func TestLambdaReservedConcurrency(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		planned cty.Value
+		want    float64
+	}{
+		"null": {
+			planned: cty.NullVal(cty.Object(map[string]cty.Type{"reserved_concurrent_executions": cty.Number})),
+		},
+		"no attribute": {
+			planned: cty.ObjectVal(map[string]cty.Value{"function_name": cty.StringVal("test")}),
+		},
+		"unreserved": {
+			planned: cty.ObjectVal(map[string]cty.Value{"reserved_concurrent_executions": cty.NumberIntVal(-1)}),
+		},
+		"unknown": {
+			planned: cty.ObjectVal(map[string]cty.Value{"reserved_concurrent_executions": cty.UnknownVal(cty.Number)}),
+		},
+		"reserved": {
+			planned: cty.ObjectVal(map[string]cty.Value{"reserved_concurrent_executions": cty.NumberIntVal(25)}),
+			want:    25,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := lambdaReservedConcurrency(testCase.planned); got != testCase.want {
+				t.Errorf("got %g, want %g", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPreflightQuotasFor(t *testing.T) {
+	t.Parallel()
+
+	config := &conns.ServiceQuotaPreflightConfig{
+		Quotas: []conns.ServiceQuotaPreflightQuota{
+			{ResourceType: "aws_vpc", ServiceCode: "vpc", QuotaCode: "L-A4707A72"},
+			{ResourceType: "aws_sqs_queue", ServiceCode: "sqs", QuotaCode: "L-TEST"},
+		},
+	}
+
+	testCases := map[string]struct {
+		resourceType string
+		want         []string
+	}{
+		"built-in and configured": {
+			resourceType: "aws_vpc",
+			want:         []string{"vpc/L-F678F1CE", "vpc/L-A4707A72"},
+		},
+		"configured": {
+			resourceType: "aws_sqs_queue",
+			want:         []string{"sqs/L-TEST"},
+		},
+		"unmapped": {
+			resourceType: "aws_s3_bucket",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range preflightQuotasFor(testCase.resourceType, config) {
+				got = append(got, v.key())
+			}
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("got %v, want %v", got, testCase.want)
+			}
+
+			for i := range got {
+				if got[i] != testCase.want[i] {
+					t.Errorf("got %v, want %v", got, testCase.want)
+				}
+			}
+		})
+	}
+}
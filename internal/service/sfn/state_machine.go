@@ -66,6 +66,12 @@ func resourceStateMachine() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
 			},
+			"definition_validation": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(definitionValidationModeService),
+				ValidateDiagFunc: enum.Validate[definitionValidationMode](),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -284,6 +290,11 @@ func resourceStateMachineRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set(names.AttrName, output.Name)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(output.Name)))
+	if v := d.Get("definition_validation").(string); v != "" {
+		d.Set("definition_validation", v)
+	} else {
+		d.Set("definition_validation", definitionValidationModeService)
+	}
 	d.Set("publish", d.Get("publish").(bool))
 	d.Set("revision_id", output.RevisionId)
 	d.Set(names.AttrRoleARN, output.RoleArn)
@@ -321,7 +332,7 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "definition_validation") {
 		// "You must include at least one of definition or roleArn or you will receive a MissingRequiredParameter error"
 		publish := d.Get("publish").(bool)
 		input := &sfn.UpdateStateMachineInput{
@@ -553,6 +564,10 @@ func stateMachineNeedsConfigUpdate(d sdkv2.ResourceDiffer) bool {
 		if attr.Computed && !attr.Optional {
 			continue
 		}
+		// Validation settings don't affect the published configuration.
+		if k == "definition_validation" {
+			continue
+		}
 
 		if d.HasChange(k) {
 			return true
@@ -564,30 +579,51 @@ func stateMachineNeedsConfigUpdate(d sdkv2.ResourceDiffer) bool {
 func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
-	if d.HasChange("definition") {
-		definition := d.Get("definition").(string)
-		if definition == "" {
-			return nil
+	if !d.HasChanges("definition", "definition_validation") || !d.NewValueKnown("definition") {
+		return nil
+	}
+
+	definition := d.Get("definition").(string)
+	if definition == "" {
+		return nil
+	}
+
+	mode := definitionValidationMode(d.Get("definition_validation").(string))
+
+	switch mode {
+	case definitionValidationModeNone:
+		return nil
+	case definitionValidationModeLocal, definitionValidationModeLocalAndService:
+		if err := validateStateMachineDefinition(definition); err != nil {
+			return fmt.Errorf("invalid Step Functions State Machine definition: %w", err)
 		}
 
-		input := &sfn.ValidateStateMachineDefinitionInput{
-			Definition: aws.String(definition),
-			Type:       awstypes.StateMachineType(d.Get(names.AttrType).(string)),
+		if mode == definitionValidationModeLocal {
+			return nil
 		}
+	}
 
-		output, err := conn.ValidateStateMachineDefinition(ctx, input)
+	input := &sfn.ValidateStateMachineDefinitionInput{
+		Definition: aws.String(definition),
+		Type:       awstypes.StateMachineType(d.Get(names.AttrType).(string)),
+	}
 
-		if err != nil {
-			return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
-		}
+	output, err := conn.ValidateStateMachineDefinition(ctx, input)
 
-		if result := output.Result; result != awstypes.ValidateStateMachineDefinitionResultCodeOk {
-			errs := tfslices.ApplyToAll(output.Diagnostics, func(v awstypes.ValidateStateMachineDefinitionDiagnostic) error {
-				return fmt.Errorf("%s (%s): %s", v.Severity, aws.ToString(v.Code), aws.ToString(v.Message))
-			})
+	if err != nil {
+		return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
+	}
 
-			return fmt.Errorf("invalid Step Functions State Machine definition: %w", errors.Join(errs...))
-		}
+	if result := output.Result; result != awstypes.ValidateStateMachineDefinitionResultCodeOk {
+		errs := tfslices.ApplyToAll(output.Diagnostics, func(v awstypes.ValidateStateMachineDefinitionDiagnostic) error {
+			if location := aws.ToString(v.Location); location != "" {
+				return fmt.Errorf("%s: %s (%s): %s", location, v.Severity, aws.ToString(v.Code), aws.ToString(v.Message))
+			}
+
+			return fmt.Errorf("%s (%s): %s", v.Severity, aws.ToString(v.Code), aws.ToString(v.Message))
+		})
+
+		return fmt.Errorf("invalid Step Functions State Machine definition: %w", errors.Join(errs...))
 	}
 
 	return nil
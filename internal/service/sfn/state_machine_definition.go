@@ -0,0 +1,830 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+	tfmaps "github.com/isometry/terraform-provider-faws/internal/maps"
+)
+
+type definitionValidationMode string
+
+const (
+	definitionValidationModeLocal           definitionValidationMode = "LOCAL"
+	definitionValidationModeLocalAndService definitionValidationMode = "LOCAL_AND_SERVICE"
+	definitionValidationModeNone            definitionValidationMode = "NONE"
+	definitionValidationModeService         definitionValidationMode = "SERVICE"
+)
+
+func (definitionValidationMode) Values() []definitionValidationMode {
+	return []definitionValidationMode{
+		definitionValidationModeLocal,
+		definitionValidationModeLocalAndService,
+		definitionValidationModeNone,
+		definitionValidationModeService,
+	}
+}
+
+const (
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+)
+
+// https://docs.aws.amazon.com/step-functions/latest/dg/concepts-error-handling.html#error-handling-error-representation.
+var predefinedErrorNames = []string{
+	"States.ALL",
+	"States.BranchFailed",
+	"States.DataLimitExceeded",
+	"States.ExceedToleratedFailureThreshold",
+	"States.HeartbeatTimeout",
+	"States.Http.Socket",
+	"States.IntrinsicFailure",
+	"States.ItemReaderFailed",
+	"States.NoChoiceMatched",
+	"States.ParameterPathFailure",
+	"States.Permissions",
+	"States.QueryEvaluationError",
+	"States.ResultPathMatchFailure",
+	"States.ResultWriterFailed",
+	"States.Runtime",
+	"States.TaskFailed",
+	"States.Timeout",
+}
+
+// https://docs.aws.amazon.com/step-functions/latest/dg/intrinsic-functions.html.
+var intrinsicFunctionNames = []string{
+	"States.Array",
+	"States.ArrayContains",
+	"States.ArrayGetItem",
+	"States.ArrayLength",
+	"States.ArrayPartition",
+	"States.ArrayRange",
+	"States.ArrayUnique",
+	"States.Base64Decode",
+	"States.Base64Encode",
+	"States.Format",
+	"States.Hash",
+	"States.JsonMerge",
+	"States.JsonToString",
+	"States.MathAdd",
+	"States.MathRandom",
+	"States.StringSplit",
+	"States.StringToJson",
+	"States.UUID",
+}
+
+var (
+	// Fields that are only supported in states that use JSONPath.
+	jsonPathOnlyFields = []string{"InputPath", "ItemsPath", "OutputPath", "Parameters", "ResultPath", "ResultSelector", "SecondsPath", "TimestampPath"}
+	// Fields that are only supported in states that use JSONata.
+	jsonataOnlyFields = []string{"Arguments", "Items", "Output"}
+	// Fields that hold nested state machines.
+	nestedStateMachineFields = []string{"Branches", "ItemProcessor", "Iterator"}
+)
+
+// validateStateMachineDefinition checks the structure of an Amazon States Language definition without calling AWS.
+// See https://states-language.net/spec.html.
+// All problems found are returned, each prefixed by the JSON Pointer of the offending value.
+func validateStateMachineDefinition(definition string) error {
+	var raw any
+	if err := json.Unmarshal([]byte(definition), &raw); err != nil {
+		return fmt.Errorf("parsing definition: %w", err)
+	}
+
+	v := &definitionValidator{
+		stateNames: make(map[string]string),
+	}
+	v.stateMachine("", raw, queryLanguageJSONPath)
+
+	return errors.Join(v.errs...)
+}
+
+type definitionValidator struct {
+	errs []error
+	// Maps each state name to the JSON Pointer of its declaration. State names are unique across the whole state machine.
+	stateNames map[string]string
+}
+
+func (v *definitionValidator) errorf(path, format string, a ...any) {
+	if path == "" {
+		path = "/"
+	}
+
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+// stateMachine validates a top-level state machine, Parallel branch or Map item processor.
+func (v *definitionValidator) stateMachine(path string, raw any, queryLanguage string) {
+	obj, ok := raw.(map[string]any)
+	if !ok {
+		v.errorf(path, "expected a JSON object")
+		return
+	}
+
+	queryLanguage = v.queryLanguage(obj, path, queryLanguage)
+	startAt, hasStartAt := v.requiredString(obj, path, "StartAt")
+
+	states, ok := obj["States"].(map[string]any)
+	if !ok || len(states) == 0 {
+		v.errorf(path, `"States" must be a non-empty JSON object`)
+		return
+	}
+
+	if hasStartAt {
+		if _, ok := states[startAt]; !ok {
+			v.errorf(path+"/StartAt", "state %q does not exist", startAt)
+			hasStartAt = false
+		}
+	}
+
+	names := tfmaps.Keys(states)
+	slices.Sort(names)
+
+	transitions := make(map[string][]string, len(states))
+	for _, name := range names {
+		statePath := jsonPointer(path, "States", name)
+
+		if other, ok := v.stateNames[name]; ok {
+			v.errorf(statePath, "state name %q is already used at %s", name, other)
+		} else {
+			v.stateNames[name] = statePath
+		}
+
+		if len(name) > 80 {
+			v.errorf(statePath, "state name must be at most 80 characters")
+		}
+
+		transitions[name] = v.state(statePath, states[name], states, queryLanguage)
+	}
+
+	if !hasStartAt {
+		return
+	}
+
+	reachable := map[string]bool{startAt: true}
+	queue := []string{startAt}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, next := range transitions[name] {
+			if !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !reachable[name] {
+			v.errorf(jsonPointer(path, "States", name), "state is not reachable from %q", startAt)
+		}
+	}
+}
+
+// state validates a single state and returns the names of the states it can transition to.
+func (v *definitionValidator) state(path string, raw any, states map[string]any, queryLanguage string) []string {
+	obj, ok := raw.(map[string]any)
+	if !ok {
+		v.errorf(path, "expected a JSON object")
+		return nil
+	}
+
+	queryLanguage = v.queryLanguage(obj, path, queryLanguage)
+	stateType, _ := v.requiredString(obj, path, "Type")
+
+	var next []string
+
+	switch stateType {
+	case "Choice":
+		v.forbidden(obj, path, "state type Choice", "End", "Next")
+		next = append(next, v.choices(obj, path, states, queryLanguage)...)
+
+		if target, ok := v.optionalString(obj, path, "Default"); ok {
+			if v.target(path+"/Default", target, states) {
+				next = append(next, target)
+			}
+		}
+
+	case "Fail", "Succeed":
+		v.forbidden(obj, path, "state type "+stateType, "End", "Next")
+
+	case "Map", "Parallel", "Pass", "Task", "Wait":
+		next = append(next, v.transition(obj, path, states)...)
+
+		switch stateType {
+		case "Map":
+			processor, field := obj["ItemProcessor"], "ItemProcessor"
+			if processor == nil {
+				processor, field = obj["Iterator"], "Iterator"
+			}
+
+			if processor == nil {
+				v.errorf(path, `"ItemProcessor" is required`)
+			} else {
+				v.stateMachine(path+"/"+field, processor, queryLanguage)
+			}
+
+		case "Parallel":
+			branches, ok := obj["Branches"].([]any)
+			if !ok || len(branches) == 0 {
+				v.errorf(path, `"Branches" must be a non-empty JSON array`)
+			}
+
+			for i, branch := range branches {
+				v.stateMachine(jsonPointer(path, "Branches", strconv.Itoa(i)), branch, queryLanguage)
+			}
+
+		case "Task":
+			v.requiredString(obj, path, "Resource")
+
+		case "Wait":
+			var n int
+			for _, field := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+				if _, ok := obj[field]; ok {
+					n++
+				}
+			}
+
+			if n != 1 {
+				v.errorf(path, `exactly one of "Seconds", "SecondsPath", "Timestamp" or "TimestampPath" is required`)
+			}
+		}
+
+		switch stateType {
+		case "Map", "Parallel", "Task":
+			v.retriers(obj, path)
+			next = append(next, v.catchers(obj, path, states, queryLanguage)...)
+
+		default:
+			v.forbidden(obj, path, "state type "+stateType, "Catch", "Retry")
+		}
+
+	case "":
+
+	default:
+		v.errorf(path+"/Type", "unsupported state type %q", stateType)
+	}
+
+	v.expressions(obj, path, queryLanguage)
+
+	return next
+}
+
+// transition validates that exactly one of Next and End is specified.
+func (v *definitionValidator) transition(obj map[string]any, path string, states map[string]any) []string {
+	target, hasNext := v.optionalString(obj, path, "Next")
+	end, hasEnd := obj["End"]
+
+	if hasEnd {
+		if end, ok := end.(bool); !ok || !end {
+			v.errorf(path+"/End", "must be true")
+		}
+	}
+
+	switch {
+	case hasNext && hasEnd:
+		v.errorf(path, `only one of "Next" or "End" can be specified`)
+	case !hasNext && !hasEnd:
+		v.errorf(path, `one of "Next" or "End" is required`)
+	}
+
+	if hasNext && v.target(path+"/Next", target, states) {
+		return []string{target}
+	}
+
+	return nil
+}
+
+func (v *definitionValidator) choices(obj map[string]any, path string, states map[string]any, queryLanguage string) []string {
+	choices, ok := obj["Choices"].([]any)
+	if !ok || len(choices) == 0 {
+		v.errorf(path, `"Choices" must be a non-empty JSON array`)
+		return nil
+	}
+
+	var next []string
+
+	for i, raw := range choices {
+		choicePath := jsonPointer(path, "Choices", strconv.Itoa(i))
+
+		choice, ok := raw.(map[string]any)
+		if !ok {
+			v.errorf(choicePath, "expected a JSON object")
+			continue
+		}
+
+		if target, ok := v.requiredString(choice, choicePath, "Next"); ok && v.target(choicePath+"/Next", target, states) {
+			next = append(next, target)
+		}
+
+		if queryLanguage == queryLanguageJSONata {
+			v.requiredString(choice, choicePath, "Condition")
+		} else {
+			v.choiceRule(choicePath, choice)
+		}
+	}
+
+	return next
+}
+
+// choiceRule validates the paths in a JSONPath Choice Rule, including nested And, Or and Not rules.
+func (v *definitionValidator) choiceRule(path string, rule map[string]any) {
+	for field, value := range rule {
+		switch {
+		case field == "Variable" || strings.HasSuffix(field, "Path") && field != "ResultPath":
+			if s, ok := value.(string); ok {
+				if err := validJSONPath(s); err != nil {
+					v.errorf(jsonPointer(path, field), "%s", err)
+				}
+			}
+
+		case field == "And" || field == "Or":
+			if rules, ok := value.([]any); ok {
+				for i, raw := range rules {
+					if rule, ok := raw.(map[string]any); ok {
+						v.choiceRule(jsonPointer(path, field, strconv.Itoa(i)), rule)
+					}
+				}
+			}
+
+		case field == "Not":
+			if rule, ok := value.(map[string]any); ok {
+				v.choiceRule(jsonPointer(path, field), rule)
+			}
+		}
+	}
+}
+
+func (v *definitionValidator) retriers(obj map[string]any, path string) {
+	raw, ok := obj["Retry"]
+	if !ok {
+		return
+	}
+
+	retriers, ok := raw.([]any)
+	if !ok {
+		v.errorf(path+"/Retry", "expected a JSON array")
+		return
+	}
+
+	for i, raw := range retriers {
+		retrierPath := jsonPointer(path, "Retry", strconv.Itoa(i))
+
+		retrier, ok := raw.(map[string]any)
+		if !ok {
+			v.errorf(retrierPath, "expected a JSON object")
+			continue
+		}
+
+		if v.errorEquals(retrier, retrierPath) && i != len(retriers)-1 {
+			v.errorf(retrierPath+"/ErrorEquals", `"States.ALL" must be in the last retrier`)
+		}
+	}
+}
+
+func (v *definitionValidator) catchers(obj map[string]any, path string, states map[string]any, queryLanguage string) []string {
+	raw, ok := obj["Catch"]
+	if !ok {
+		return nil
+	}
+
+	catchers, ok := raw.([]any)
+	if !ok {
+		v.errorf(path+"/Catch", "expected a JSON array")
+		return nil
+	}
+
+	var next []string
+
+	for i, raw := range catchers {
+		catcherPath := jsonPointer(path, "Catch", strconv.Itoa(i))
+
+		catcher, ok := raw.(map[string]any)
+		if !ok {
+			v.errorf(catcherPath, "expected a JSON object")
+			continue
+		}
+
+		if v.errorEquals(catcher, catcherPath) && i != len(catchers)-1 {
+			v.errorf(catcherPath+"/ErrorEquals", `"States.ALL" must be in the last catcher`)
+		}
+
+		if target, ok := v.requiredString(catcher, catcherPath, "Next"); ok && v.target(catcherPath+"/Next", target, states) {
+			next = append(next, target)
+		}
+
+		v.expressions(catcher, catcherPath, queryLanguage)
+	}
+
+	return next
+}
+
+// errorEquals validates the ErrorEquals field of a retrier or catcher and returns whether it matches States.ALL.
+func (v *definitionValidator) errorEquals(obj map[string]any, path string) bool {
+	path += "/ErrorEquals"
+
+	errorNames, ok := obj["ErrorEquals"].([]any)
+	if !ok || len(errorNames) == 0 {
+		v.errorf(path, "must be a non-empty JSON array")
+		return false
+	}
+
+	var all bool
+
+	for i, raw := range errorNames {
+		errorName, ok := raw.(string)
+		if !ok {
+			v.errorf(jsonPointer(path, strconv.Itoa(i)), "expected a string")
+			continue
+		}
+
+		if strings.HasPrefix(errorName, "States.") && !slices.Contains(predefinedErrorNames, errorName) {
+			v.errorf(jsonPointer(path, strconv.Itoa(i)), "unknown predefined error name %q", errorName)
+		}
+
+		if errorName == "States.ALL" {
+			all = true
+
+			if len(errorNames) > 1 {
+				v.errorf(path, `"States.ALL" must appear alone`)
+			}
+		}
+	}
+
+	return all
+}
+
+// expressions validates the JSONPath or JSONata expressions in a state, retrier or catcher.
+// Nested state machines are validated separately.
+func (v *definitionValidator) expressions(obj map[string]any, path string, queryLanguage string) {
+	for field, value := range obj {
+		if slices.Contains(nestedStateMachineFields, field) {
+			continue
+		}
+
+		fieldPath := jsonPointer(path, field)
+
+		if queryLanguage == queryLanguageJSONata {
+			if slices.Contains(jsonPathOnlyFields, field) {
+				v.errorf(fieldPath, "field is not supported with JSONata; use %q or %q", "Arguments", "Output")
+				continue
+			}
+
+			v.jsonataExpressions(fieldPath, value)
+			continue
+		}
+
+		if slices.Contains(jsonataOnlyFields, field) {
+			v.errorf(fieldPath, "field is only supported with JSONata")
+			continue
+		}
+
+		switch field {
+		case "InputPath", "ItemsPath", "OutputPath", "ResultPath", "SecondsPath", "TimestampPath":
+			// A null InputPath, OutputPath or ResultPath is valid.
+			if s, ok := value.(string); ok {
+				if err := validJSONPath(s); err != nil {
+					v.errorf(fieldPath, "%s", err)
+				}
+			}
+
+		case "ItemSelector", "Parameters", "ResultSelector":
+			v.payloadTemplate(fieldPath, value)
+		}
+	}
+}
+
+// payloadTemplate validates the paths and intrinsic functions in a JSONPath payload template.
+func (v *definitionValidator) payloadTemplate(path string, raw any) {
+	switch raw := raw.(type) {
+	case map[string]any:
+		for field, value := range raw {
+			fieldPath := jsonPointer(path, field)
+
+			if !strings.HasSuffix(field, ".$") {
+				v.payloadTemplate(fieldPath, value)
+				continue
+			}
+
+			s, ok := value.(string)
+			if !ok {
+				v.errorf(fieldPath, "value of a field ending in \".$\" must be a string")
+				continue
+			}
+
+			var err error
+			if strings.HasPrefix(s, "States.") {
+				err = validIntrinsicFunction(s)
+			} else {
+				err = validJSONPath(s)
+			}
+
+			if err != nil {
+				v.errorf(fieldPath, "%s", err)
+			}
+		}
+
+	case []any:
+		for i, value := range raw {
+			v.payloadTemplate(jsonPointer(path, strconv.Itoa(i)), value)
+		}
+	}
+}
+
+// jsonataExpressions validates any JSONata expressions, strings enclosed in "{%" and "%}", in a value.
+func (v *definitionValidator) jsonataExpressions(path string, raw any) {
+	switch raw := raw.(type) {
+	case string:
+		if err := validJSONataString(raw); err != nil {
+			v.errorf(path, "%s", err)
+		}
+
+	case map[string]any:
+		for field, value := range raw {
+			v.jsonataExpressions(jsonPointer(path, field), value)
+		}
+
+	case []any:
+		for i, value := range raw {
+			v.jsonataExpressions(jsonPointer(path, strconv.Itoa(i)), value)
+		}
+	}
+}
+
+func (v *definitionValidator) queryLanguage(obj map[string]any, path string, parent string) string {
+	queryLanguage, ok := v.optionalString(obj, path, "QueryLanguage")
+	if !ok {
+		return parent
+	}
+
+	switch queryLanguage {
+	case queryLanguageJSONata:
+		return queryLanguage
+
+	case queryLanguageJSONPath:
+		if parent == queryLanguageJSONata {
+			v.errorf(path+"/QueryLanguage", "cannot be %s when the state machine uses %s", queryLanguageJSONPath, queryLanguageJSONata)
+			return parent
+		}
+
+		return queryLanguage
+
+	default:
+		v.errorf(path+"/QueryLanguage", "must be %q or %q", queryLanguageJSONPath, queryLanguageJSONata)
+		return parent
+	}
+}
+
+func (v *definitionValidator) target(path, name string, states map[string]any) bool {
+	if _, ok := states[name]; !ok {
+		v.errorf(path, "state %q does not exist", name)
+		return false
+	}
+
+	return true
+}
+
+func (v *definitionValidator) forbidden(obj map[string]any, path, context string, fields ...string) {
+	for _, field := range fields {
+		if _, ok := obj[field]; ok {
+			v.errorf(jsonPointer(path, field), "field is not supported with %s", context)
+		}
+	}
+}
+
+func (v *definitionValidator) requiredString(obj map[string]any, path, field string) (string, bool) {
+	if _, ok := obj[field]; !ok {
+		v.errorf(path, "%q is required", field)
+		return "", false
+	}
+
+	return v.optionalString(obj, path, field)
+}
+
+func (v *definitionValidator) optionalString(obj map[string]any, path, field string) (string, bool) {
+	raw, ok := obj[field]
+	if !ok {
+		return "", false
+	}
+
+	s, ok := raw.(string)
+	if !ok || s == "" {
+		v.errorf(jsonPointer(path, field), "must be a non-empty string")
+		return "", false
+	}
+
+	return s, true
+}
+
+// jsonPointer appends RFC 6901 reference tokens to a JSON Pointer.
+func jsonPointer(path string, tokens ...string) string {
+	var sb strings.Builder
+	sb.WriteString(path)
+
+	for _, token := range tokens {
+		sb.WriteByte('/')
+		sb.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+
+	return sb.String()
+}
+
+// validJSONPath checks the syntax of a JSONPath (or context object path) expression.
+func validJSONPath(s string) error {
+	if !strings.HasPrefix(s, "$") {
+		return fmt.Errorf("invalid JSONPath %q: must start with \"$\"", s)
+	}
+
+	if strings.HasSuffix(s, ".") {
+		return fmt.Errorf("invalid JSONPath %q: must not end with \".\"", s)
+	}
+
+	if err := checkBalanced(s, `'"`); err != nil {
+		return fmt.Errorf("invalid JSONPath %q: %w", s, err)
+	}
+
+	return nil
+}
+
+var intrinsicFunctionRegexp = regexache.MustCompile(`^(States\.[0-9A-Za-z]+)\((.*)\)$`)
+
+// validIntrinsicFunction checks the syntax of an intrinsic function call, such as States.Format('{}', $.name).
+func validIntrinsicFunction(s string) error {
+	matches := intrinsicFunctionRegexp.FindStringSubmatch(s)
+	if matches == nil {
+		return fmt.Errorf("invalid intrinsic function call %q", s)
+	}
+
+	if !slices.Contains(intrinsicFunctionNames, matches[1]) {
+		return fmt.Errorf("unknown intrinsic function %q", matches[1])
+	}
+
+	if err := checkBalanced(matches[2], `'`); err != nil {
+		return fmt.Errorf("invalid intrinsic function call %q: %w", s, err)
+	}
+
+	return nil
+}
+
+// validJSONataString checks the syntax of a string that may hold a JSONata expression.
+// Strings that don't start with "{%" are literals.
+func validJSONataString(s string) error {
+	if !strings.HasPrefix(s, "{%") {
+		return nil
+	}
+
+	expression, ok := strings.CutSuffix(s, "%}")
+	if !ok || len(s) < len("{%%}") {
+		return fmt.Errorf("invalid JSONata expression %q: must end with \"%%}\"", s)
+	}
+
+	expression = strings.TrimSpace(strings.TrimPrefix(expression, "{%"))
+	if expression == "" {
+		return fmt.Errorf("invalid JSONata expression %q: empty expression", s)
+	}
+
+	if err := checkBalanced(stripJSONataRegexes(stripJSONataComments(expression)), "'\"`"); err != nil {
+		return fmt.Errorf("invalid JSONata expression %q: %w", s, err)
+	}
+
+	return nil
+}
+
+func stripJSONataComments(s string) string {
+	for {
+		start := strings.Index(s, "/*")
+		if start < 0 {
+			return s
+		}
+
+		end := strings.Index(s[start+2:], "*/")
+		if end < 0 {
+			return s[:start]
+		}
+
+		s = s[:start] + " " + s[start+2+end+2:]
+	}
+}
+
+// stripJSONataRegexes blanks out JSONata regular expression literals, e.g. `/[a-z(]+/i`,
+// whose contents need not have balanced brackets or quotes.
+// A `/` starts a regular expression where an operand is expected, otherwise it is the division operator.
+func stripJSONataRegexes(s string) string {
+	var sb strings.Builder
+	var quote rune
+	var escaped bool
+	operandExpected := true
+	runes := []rune(s)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+			sb.WriteRune(r)
+
+			continue
+		}
+
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+			operandExpected = false
+		case r == '/' && operandExpected:
+			// Skip to the end of the regular expression literal, then its flags.
+			var inClass bool
+		regex:
+			for i++; i < len(runes); i++ {
+				switch c := runes[i]; {
+				case c == '\\':
+					i++
+				case c == '[':
+					inClass = true
+				case c == ']':
+					inClass = false
+				case c == '/' && !inClass:
+					break regex
+				}
+			}
+			for i+1 < len(runes) && unicode.IsLetter(runes[i+1]) {
+				i++
+			}
+			sb.WriteRune(' ')
+			operandExpected = false
+
+			continue
+		case unicode.IsSpace(r):
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '$' || r == '_' || r == ')' || r == ']' || r == '}':
+			operandExpected = false
+		default:
+			operandExpected = true
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// checkBalanced checks that brackets are balanced and string literals are terminated.
+// Characters in quotes start and end string literals, within which a backslash escapes the next character.
+func checkBalanced(s string, quotes string) error {
+	var stack []rune
+	var quote rune
+	var escaped bool
+
+	for _, r := range s {
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch {
+		case strings.ContainsRune(quotes, r):
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			stack = append(stack, r)
+		case r == ')' || r == ']' || r == '}':
+			open := map[rune]rune{')': '(', ']': '[', '}': '{'}[r]
+			if len(stack) == 0 || stack[len(stack)-1] != open {
+				return fmt.Errorf("unexpected %q", r)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated string literal")
+	}
+
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateStateMachineDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition string
+		wantErrs   []string
+	}{
+		"valid": {
+			definition: `{
+  "Comment": "Valid",
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [{"Variable": "$.flag", "BooleanEquals": true, "Next": "Invoke"}],
+      "Default": "Done"
+    },
+    "Invoke": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"FunctionName": "f", "Payload.$": "States.Format('hello {}', $.name)"},
+      "ResultPath": "$.result",
+      "Retry": [{"ErrorEquals": ["States.Timeout"]}, {"ErrorEquals": ["States.ALL"]}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "ResultPath": "$.error", "Next": "Failed"}],
+      "Next": "Fan"
+    },
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "Inner", "States": {"Inner": {"Type": "Pass", "End": true}}}],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "ItemProcessor": {"StartAt": "Item", "States": {"Item": {"Type": "Wait", "Seconds": 1, "End": true}}},
+      "End": true
+    },
+    "Failed": {"Type": "Fail"},
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		"valid JSONata": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Invoke",
+  "States": {
+    "Invoke": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Arguments": {"FunctionName": "f", "Payload": "{% $states.input.items[0] /* first */ %}"},
+      "Output": "{% $states.result.Payload %}",
+      "Next": "Choose"
+    },
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $states.input.ok = true and $contains($states.input.name, /^[a-z(\\/]+'/i) and ($states.input.n / 2) > 1 %}", "Next": "Done"}],
+      "Default": "Done"
+    },
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		"invalid JSON": {
+			definition: `{`,
+			wantErrs:   []string{"parsing definition"},
+		},
+		"missing States": {
+			definition: `{"StartAt": "A", "Status": {}}`,
+			wantErrs:   []string{`/: "States" must be a non-empty JSON object`},
+		},
+		"unknown StartAt": {
+			definition: `{"StartAt": "B", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			wantErrs:   []string{`/StartAt: state "B" does not exist`},
+		},
+		"transitions": {
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "Next": "B", "End": true},
+    "B": {"Type": "Pass"},
+    "C": {"Type": "Succeed", "Next": "A"},
+    "D": {"Type": "Pass", "Next": "Missing"}
+  }
+}`,
+			wantErrs: []string{
+				`/States/A: only one of "Next" or "End" can be specified`,
+				`/States/B: one of "Next" or "End" is required`,
+				`/States/C/Next: field is not supported with state type Succeed`,
+				`/States/C: state is not reachable from "A"`,
+				`/States/D/Next: state "Missing" does not exist`,
+				`/States/D: state is not reachable from "A"`,
+			},
+		},
+		"state types": {
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Task", "Next": "B"},
+    "B": {"Type": "Wait", "Seconds": 1, "SecondsPath": "$.s", "Next": "C"},
+    "C": {"Type": "Sleep", "End": true}
+  }
+}`,
+			wantErrs: []string{
+				`/States/A: "Resource" is required`,
+				`/States/B: exactly one of "Seconds", "SecondsPath", "Timestamp" or "TimestampPath" is required`,
+				`/States/C/Type: unsupported state type "Sleep"`,
+			},
+		},
+		"error names": {
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Retry": [{"ErrorEquals": ["States.ALL"]}, {"ErrorEquals": ["States.Timeoutt", "Custom.Error"]}],
+      "Catch": [{"ErrorEquals": ["States.ALL", "States.Timeout"], "Next": "B"}, {"ErrorEquals": [], "Next": "Missing"}],
+      "End": true
+    },
+    "B": {"Type": "Pass", "Retry": [], "End": true}
+  }
+}`,
+			wantErrs: []string{
+				`/States/A/Retry/0/ErrorEquals: "States.ALL" must be in the last retrier`,
+				`/States/A/Retry/1/ErrorEquals/0: unknown predefined error name "States.Timeoutt"`,
+				`/States/A/Catch/0/ErrorEquals: "States.ALL" must appear alone`,
+				`/States/A/Catch/0/ErrorEquals: "States.ALL" must be in the last catcher`,
+				`/States/A/Catch/1/ErrorEquals: must be a non-empty JSON array`,
+				`/States/A/Catch/1/Next: state "Missing" does not exist`,
+				`/States/B/Retry: field is not supported with state type Pass`,
+			},
+		},
+		"JSONPath syntax": {
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Pass",
+      "InputPath": "input",
+      "ResultPath": "$.a[0",
+      "OutputPath": null,
+      "Parameters": {"x.$": "$.b.", "y.$": "States.Nope($.c)", "z": [{"w.$": "States.Format('{}', $.d"}]},
+      "Output": "{% $states.input %}",
+      "Next": "B"
+    },
+    "B": {"Type": "Choice", "Choices": [{"Not": {"Variable": "x", "IsPresent": true}, "Next": "A"}]}
+  }
+}`,
+			wantErrs: []string{
+				`/States/A/InputPath: invalid JSONPath "input": must start with "$"`,
+				`/States/A/ResultPath: invalid JSONPath "$.a[0": unclosed '['`,
+				`/States/A/Parameters/x.$: invalid JSONPath "$.b.": must not end with "."`,
+				`/States/A/Parameters/y.$: unknown intrinsic function "States.Nope"`,
+				`/States/A/Parameters/z/0/w.$: invalid intrinsic function call`,
+				`/States/A/Output: field is only supported with JSONata`,
+				`/States/B/Choices/0/Not/Variable: invalid JSONPath "x"`,
+			},
+		},
+		"JSONata syntax": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Pass",
+      "InputPath": "$.a",
+      "Output": {"a": "{% $states.input.(a %}", "b": "{% %}", "c": "{% 'x %}", "d": "{% x"},
+      "Next": "B"
+    },
+    "B": {"Type": "Choice", "QueryLanguage": "JSONPath", "Choices": [{"Next": "A"}]}
+  }
+}`,
+			wantErrs: []string{
+				`/States/A/InputPath: field is not supported with JSONata`,
+				`/States/A/Output/a: invalid JSONata expression "{% $states.input.(a %}": unclosed '('`,
+				`/States/A/Output/b: invalid JSONata expression "{% %}": empty expression`,
+				`/States/A/Output/c: invalid JSONata expression "{% 'x %}": unterminated string literal`,
+				`/States/A/Output/d: invalid JSONata expression "{% x": must end with "%}"`,
+				`/States/B/QueryLanguage: cannot be JSONPath when the state machine uses JSONata`,
+				`/States/B/Choices/0: "Condition" is required`,
+			},
+		},
+		"nesting": {
+			definition: `{
+  "StartAt": "P",
+  "States": {
+    "P": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "X", "States": {"X": {"Type": "Pass", "Next": "M"}}},
+        {"StartAt": "P", "States": {"P": {"Type": "Pass", "End": true}}}
+      ],
+      "Next": "M"
+    },
+    "M": {"Type": "Map", "Next": "E"},
+    "E": {"Type": "Parallel", "Branches": [], "End": true}
+  }
+}`,
+			wantErrs: []string{
+				`/States/P/Branches/0/States/X/Next: state "M" does not exist`,
+				`/States/P/Branches/1/States/P: state name "P" is already used at /States/P`,
+				`/States/M: "ItemProcessor" is required`,
+				`/States/E: "Branches" must be a non-empty JSON array`,
+			},
+		},
+		"escaped state names": {
+			definition: `{"StartAt": "a/b~c", "States": {"a/b~c": {"Type": "Pass"}}}`,
+			wantErrs:   []string{`/States/a~1b~0c: one of "Next" or "End" is required`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateStateMachineDefinition(testCase.definition)
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			got := err.Error()
+			for _, want := range testCase.wantErrs {
+				if !strings.Contains(got, want) {
+					t.Errorf("expected error to contain %q, got:\n%s", want, got)
+				}
+			}
+
+			if n, want := strings.Count(got, "\n")+1, len(testCase.wantErrs); n != want {
+				t.Errorf("expected %d errors, got %d:\n%s", want, n, got)
+			}
+		})
+	}
+}
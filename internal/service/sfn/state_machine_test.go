@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/isometry/terraform-provider-faws/internal/acctest"
 	"github.com/isometry/terraform-provider-faws/internal/conns"
@@ -63,9 +64,10 @@ func TestAccSFNStateMachine_createUpdate(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_validation"},
 			},
 			{
 				Config: testAccStateMachineConfig_basic(rName, 10),
@@ -228,9 +230,10 @@ func TestAccSFNStateMachine_nameGenerated(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_validation"},
 			},
 		},
 	})
@@ -257,9 +260,10 @@ func TestAccSFNStateMachine_namePrefix(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_validation"},
 			},
 		},
 	})
@@ -289,7 +293,7 @@ func TestAccSFNStateMachine_publish(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateVerifyIgnore: []string{"definition_validation", "publish"},
 			},
 		},
 	})
@@ -316,9 +320,10 @@ func TestAccSFNStateMachine_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_validation"},
 			},
 			{
 				Config: testAccStateMachineConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
@@ -362,9 +367,10 @@ func TestAccSFNStateMachine_tracing(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_validation"},
 			},
 			{
 				Config: testAccStateMachineConfig_tracingEnable(rName),
@@ -480,9 +486,10 @@ func TestAccSFNStateMachine_encryptionConfigurationCustomerManagedKMSKey(t *test
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_validation"},
 			},
 			//Update periodReuseSeconds
 			{
@@ -568,9 +575,10 @@ func TestAccSFNStateMachine_encryptionConfigurationServiceOwnedKey(t *testing.T)
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_validation"},
 			},
 			//Update Encryption Type
 			{
@@ -596,6 +604,7 @@ func TestAccSFNStateMachine_encryptionConfigurationServiceOwnedKey(t *testing.T)
 
 func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
 	ctx := acctest.Context(t)
+	resourceName := "aws_sfn_state_machine.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -608,6 +617,33 @@ func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
 				Config:      testAccStateMachineConfig_invalidDefinition(rName),
 				ExpectError: regexache.MustCompile("invalid Step Functions State Machine definition: .+"),
 			},
+			{
+				Config:      testAccStateMachineConfig_unreachableState(rName),
+				ExpectError: regexache.MustCompile(`/States/Orphan: state is not reachable from "HelloWorld"`),
+			},
+			{
+				Config: testAccStateMachineConfig_definitionValidation(rName, "SERVICE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "definition_validation", "SERVICE"),
+				),
+			},
+			{
+				Config: testAccStateMachineConfig_definitionValidation(rName, "NONE"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "definition_validation", "NONE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_validation"},
+			},
 		},
 	})
 }
@@ -1279,3 +1315,53 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineConfig_unreachableState(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name                  = %[1]q
+  role_arn              = aws_iam_role.for_sfn.arn
+  definition_validation = "LOCAL"
+
+  definition = <<EOF
+{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    },
+    "Orphan": {
+      "Type": "Pass",
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName))
+}
+
+func testAccStateMachineConfig_definitionValidation(rName, mode string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name                  = %[1]q
+  role_arn              = aws_iam_role.for_sfn.arn
+  definition_validation = %[2]q
+
+  definition = <<EOF
+{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, mode))
+}
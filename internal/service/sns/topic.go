@@ -255,6 +255,7 @@ func resourceTopic() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			resourceTopicCustomizeDiff,
 			verify.SetTagsDiff,
+			verify.SetPlanTimeValue(names.AttrARN, verify.RegionalARNFromPlan("sns", "%s", names.AttrName)),
 		),
 
 		Schema: topicSchema,
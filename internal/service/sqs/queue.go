@@ -205,6 +205,8 @@ func resourceQueue() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			resourceQueueCustomizeDiff,
 			verify.SetTagsDiff,
+			verify.SetPlanTimeValue(names.AttrARN, verify.RegionalARNFromPlan("sqs", "%s", names.AttrName)),
+			verify.SetPlanTimeValue(names.AttrURL, queueURLFromPlan),
 		),
 
 		Schema: queueSchema,
@@ -415,6 +417,28 @@ func queueName(d sdkv2.ResourceDiffer) string {
 	return create.NewNameGenerator(optFns...).Generate()
 }
 
+// queueURLFromPlan returns the URL of the SQS queue to be created, if its name is known.
+// The URL isn't determined when a custom, FIPS or dual-stack endpoint is used as the queue URL's hostname may then differ.
+func queueURLFromPlan(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) (string, bool) {
+	c := meta.(*conns.AWSClient)
+	if c.AccountID(ctx) == "" {
+		return "", false
+	}
+
+	name, ok := verify.PlannedString(diff, names.AttrName)
+	if !ok {
+		return "", false
+	}
+
+	options := c.SQSClient(ctx).Options()
+	if options.BaseEndpoint != nil || options.EndpointOptions.UseFIPSEndpoint == aws.FIPSEndpointStateEnabled || options.EndpointOptions.UseDualStackEndpoint == aws.DualStackEndpointStateEnabled {
+		return "", false
+	}
+
+	// https://sqs.us-west-2.amazonaws.com/123456789012/queueName
+	return fmt.Sprintf("https://%s/%s/%s", c.RegionalHostname(ctx, "sqs"), c.AccountID(ctx), name), true
+}
+
 // queueNameFromURL returns the SQS queue name from the specified URL.
 func queueNameFromURL(u string) (string, error) {
 	v, err := url.Parse(u)
@@ -0,0 +1,538 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+	"github.com/isometry/terraform-provider-faws/internal/errs/sdkdiag"
+	"github.com/isometry/terraform-provider-faws/internal/flex"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	// Maximum amount of time to wait for newly launched instances to register as managed nodes.
+	commandInstanceRegistrationTimeout = 10 * time.Minute
+
+	// Maximum length of the standard error content included in error messages.
+	commandErrorOutputMaxLength = 500
+)
+
+// @SDKResource("aws_ssm_command", name="Command")
+func resourceCommand() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCommandCreate,
+		ReadWithoutTimeout:   resourceCommandRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrComment: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"document_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     50,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			"invocation": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"plugin_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"standard_error_content": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"standard_output_content": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a number without leading zeros or a percentage between 1% and 100% without leading zeros and ending with the percentage symbol"),
+			},
+			"max_errors": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "0",
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be zero, a number without leading zeros, or a percentage between 1% and 100% without leading zeros and ending with the percentage symbol"),
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 163),
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	documentName := d.Get("document_name").(string)
+	maxErrors := d.Get("max_errors").(string)
+	input := &ssm.SendCommandInput{
+		DocumentName: aws.String(documentName),
+		MaxErrors:    aws.String(maxErrors),
+	}
+
+	if v, ok := d.GetOk(names.AttrComment); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("document_version"); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.(map[string]interface{})) > 0 {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("targets"); ok && len(v.([]interface{})) > 0 {
+		input.Targets = expandTargets(v.([]interface{}))
+	}
+
+	// Newly launched instances are rejected until the SSM Agent has registered them as managed nodes.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.InvalidInstanceId](ctx, commandInstanceRegistrationTimeout, func() (interface{}, error) {
+		return conn.SendCommand(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "sending SSM Command (%s): %s", documentName, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*ssm.SendCommandOutput).Command.CommandId))
+
+	command, err := waitCommandComplete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || tfresource.TimedOut(err) {
+			log.Printf("[DEBUG] Cancelling SSM Command (%s)", d.Id())
+			cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 1*time.Minute)
+			defer cancel()
+			if _, err := conn.CancelCommand(cancelCtx, &ssm.CancelCommandInput{CommandId: aws.String(d.Id())}); err != nil {
+				log.Printf("[WARN] Cancelling SSM Command (%s): %s", d.Id(), err)
+			}
+		}
+
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Command (%s) to complete: %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrStatus, command.Status)
+
+	invocations, err := findCommandInvocationResults(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s) invocations: %s", d.Id(), err)
+	}
+
+	if err := d.Set("invocation", flattenCommandInvocationResults(invocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting invocation: %s", err)
+	}
+
+	if err := commandInvocationsError(invocations, maxErrors); err != nil {
+		return sdkdiag.AppendErrorf(diags, "SSM Command (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCommandRead(ctx, d, meta)...)
+}
+
+func resourceCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	command, err := findCommandByID(ctx, conn, d.Id())
+
+	// Command history is retained for a limited time. The resource represents a completed command, so it is kept in state.
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Command (%s) not found, keeping in state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrComment, command.Comment)
+	d.Set("document_name", command.DocumentName)
+	d.Set("max_concurrency", command.MaxConcurrency)
+	d.Set("max_errors", command.MaxErrors)
+	d.Set(names.AttrStatus, command.Status)
+
+	return diags
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, input)
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocations(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   true,
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationByThreePartKey(ctx context.Context, conn *ssm.Client, commandID, instanceID, pluginName string) (*ssm.GetCommandInvocationOutput, error) {
+	input := &ssm.GetCommandInvocationInput{
+		CommandId:  aws.String(commandID),
+		InstanceId: aws.String(instanceID),
+		PluginName: aws.String(pluginName),
+	}
+
+	output, err := conn.GetCommandInvocation(ctx, input)
+
+	if errs.IsA[*awstypes.InvocationDoesNotExist](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findCommandInvocationResults returns the result of each step of the command on each instance,
+// waiting for every step to complete.
+func findCommandInvocationResults(ctx context.Context, conn *ssm.Client, id string, timeout time.Duration) ([]*ssm.GetCommandInvocationOutput, error) {
+	invocations, err := findCommandInvocations(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*ssm.GetCommandInvocationOutput
+
+	for _, invocation := range invocations {
+		instanceID := aws.ToString(invocation.InstanceId)
+
+		// Invocations that were never delivered, e.g. to a stopped instance, have no steps.
+		if len(invocation.CommandPlugins) == 0 {
+			output = append(output, &ssm.GetCommandInvocationOutput{
+				CommandId:     invocation.CommandId,
+				InstanceId:    invocation.InstanceId,
+				Status:        invocation.Status,
+				StatusDetails: invocation.StatusDetails,
+			})
+			continue
+		}
+
+		for _, plugin := range invocation.CommandPlugins {
+			result, err := waitCommandInvocationComplete(ctx, conn, id, instanceID, aws.ToString(plugin.Name), timeout)
+
+			if err != nil {
+				return nil, fmt.Errorf("waiting for instance (%s) step (%s): %w", instanceID, aws.ToString(plugin.Name), err)
+			}
+
+			output = append(output, result)
+		}
+	}
+
+	return output, nil
+}
+
+func statusCommand(ctx context.Context, conn *ssm.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCommandByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusCommandInvocation(ctx context.Context, conn *ssm.Client, commandID, instanceID, pluginName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCommandInvocationByThreePartKey(ctx, conn, commandID, instanceID, pluginName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// waitCommandComplete waits for the command to complete on every instance, successfully or not.
+// Whether the command failed is determined from its invocations.
+func waitCommandComplete(ctx context.Context, conn *ssm.Client, id string, timeout time.Duration) (*awstypes.Command, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.CommandStatusPending, awstypes.CommandStatusInProgress, awstypes.CommandStatusCancelling),
+		Target:     enum.Slice(awstypes.CommandStatusSuccess, awstypes.CommandStatusCancelled, awstypes.CommandStatusFailed, awstypes.CommandStatusTimedOut),
+		Refresh:    statusCommand(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Command); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCommandInvocationComplete(ctx context.Context, conn *ssm.Client, commandID, instanceID, pluginName string, timeout time.Duration) (*ssm.GetCommandInvocationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.CommandInvocationStatusPending, awstypes.CommandInvocationStatusInProgress, awstypes.CommandInvocationStatusDelayed, awstypes.CommandInvocationStatusCancelling),
+		Target:     enum.Slice(awstypes.CommandInvocationStatusSuccess, awstypes.CommandInvocationStatusCancelled, awstypes.CommandInvocationStatusFailed, awstypes.CommandInvocationStatusTimedOut),
+		Refresh:    statusCommandInvocation(ctx, conn, commandID, instanceID, pluginName),
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssm.GetCommandInvocationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// commandInvocationsError returns an error describing the unsuccessful invocations
+// if the number of instances on which the command failed exceeds maxErrors.
+func commandInvocationsError(invocations []*ssm.GetCommandInvocationOutput, maxErrors string) error {
+	instances := make(map[string]bool)
+	var failures []string
+
+	for _, invocation := range invocations {
+		instanceID := aws.ToString(invocation.InstanceId)
+		if _, ok := instances[instanceID]; !ok {
+			instances[instanceID] = false
+		}
+
+		if invocation.Status == awstypes.CommandInvocationStatusSuccess {
+			continue
+		}
+
+		instances[instanceID] = true
+
+		failure := fmt.Sprintf("instance %s", instanceID)
+		if v := aws.ToString(invocation.PluginName); v != "" {
+			failure += fmt.Sprintf(" step %s", v)
+		}
+		failure += fmt.Sprintf(": %s", invocation.Status)
+		if v := aws.ToString(invocation.StatusDetails); v != "" && v != string(invocation.Status) {
+			failure += fmt.Sprintf(" (%s)", v)
+		}
+		if invocation.ResponseCode != 0 {
+			failure += fmt.Sprintf(", exit code %d", invocation.ResponseCode)
+		}
+		if v := strings.TrimSpace(aws.ToString(invocation.StandardErrorContent)); v != "" {
+			if len(v) > commandErrorOutputMaxLength {
+				v = "..." + v[len(v)-commandErrorOutputMaxLength:]
+			}
+			failure += ": " + v
+		}
+		failures = append(failures, failure)
+	}
+
+	var failed int
+	for _, v := range instances {
+		if v {
+			failed++
+		}
+	}
+
+	if !commandErrorThresholdExceeded(maxErrors, failed, len(instances)) {
+		return nil
+	}
+
+	return fmt.Errorf("failed on %d of %d instances, exceeding max_errors (%s):\n%s", failed, len(instances), maxErrors, strings.Join(failures, "\n"))
+}
+
+// commandErrorThresholdExceeded returns whether the number of failures exceeds maxErrors,
+// either an absolute number or a percentage of the total.
+func commandErrorThresholdExceeded(maxErrors string, failed, total int) bool {
+	if v, ok := strings.CutSuffix(maxErrors, "%"); ok {
+		percent, _ := strconv.Atoi(v)
+		return failed*100 > percent*total
+	}
+
+	n, _ := strconv.Atoi(maxErrors)
+	return failed > n
+}
+
+func flattenCommandInvocationResults(apiObjects []*ssm.GetCommandInvocationOutput) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrInstanceID:      aws.ToString(apiObject.InstanceId),
+			"plugin_name":             aws.ToString(apiObject.PluginName),
+			"response_code":           int(apiObject.ResponseCode),
+			"standard_error_content":  aws.ToString(apiObject.StandardErrorContent),
+			"standard_output_content": aws.ToString(apiObject.StandardOutputContent),
+			names.AttrStatus:          string(apiObject.Status),
+			"status_details":          aws.ToString(apiObject.StatusDetails),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	tfssm "github.com/isometry/terraform-provider-faws/internal/service/ssm"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+func TestAccSSMCommand_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var command awstypes.Command
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command.test"
+	instanceResourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommandConfig_basic(rName, "echo hello", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName, &command),
+					resource.TestCheckResourceAttr(resourceName, "document_name", "AWS-RunShellScript"),
+					resource.TestCheckResourceAttr(resourceName, "invocation.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "invocation.0.instance_id", instanceResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.plugin_name", "aws:runShellScript"),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.response_code", "0"),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.standard_error_content", ""),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.standard_output_content", "hello\n"),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.status", string(awstypes.CommandInvocationStatusSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "max_errors", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.CommandStatusSuccess)),
+				),
+			},
+			{
+				Config:   testAccCommandConfig_basic(rName, "echo hello", "1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCommandConfig_basic(rName, "echo hello", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName, &command),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.redeployment", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommand_targets(t *testing.T) {
+	ctx := acctest.Context(t)
+	var command awstypes.Command
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command.test"
+	instanceResourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommandConfig_targets(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName, &command),
+					resource.TestCheckResourceAttr(resourceName, "invocation.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "invocation.0.instance_id", instanceResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.status", string(awstypes.CommandInvocationStatusSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.key", "tag:Name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommand_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCommandConfig_basic(rName, "echo oops >&2; exit 3", "1"),
+				ExpectError: regexp.MustCompile(`failed on 1 of 1 instances(.|\n)*exit code 3: oops`),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommand_maxErrors(t *testing.T) {
+	ctx := acctest.Context(t)
+	var command awstypes.Command
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCommandConfig_maxErrors(rName, "echo oops >&2; exit 3", "100%"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName, &command),
+					resource.TestCheckResourceAttr(resourceName, "invocation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.response_code", "3"),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.standard_error_content", "oops\n"),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.status", string(awstypes.CommandInvocationStatusFailed)),
+					resource.TestCheckResourceAttr(resourceName, "max_errors", "100%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCommandExists(ctx context.Context, n string, v *awstypes.Command) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindCommandByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCommandConfig_basic(rName, commands, trigger string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+resource "aws_ssm_command" "test" {
+  document_name = "AWS-RunShellScript"
+  instance_ids  = [aws_instance.test.id]
+
+  parameters = {
+    commands = %[1]q
+  }
+
+  triggers = {
+    redeployment = %[2]q
+  }
+}
+`, commands, trigger))
+}
+
+func testAccCommandConfig_targets(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+# Targets only match instances that have registered as managed nodes.
+resource "terraform_data" "registration" {
+  input = aws_instance.test.id
+
+  provisioner "local-exec" {
+    command = "sleep 120"
+  }
+}
+
+resource "aws_ssm_command" "test" {
+  document_name = "AWS-RunShellScript"
+
+  parameters = {
+    commands = "echo hello"
+  }
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  depends_on = [terraform_data.registration]
+}
+`, rName))
+}
+
+func testAccCommandConfig_maxErrors(rName, commands, maxErrors string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+resource "aws_ssm_command" "test" {
+  document_name = "AWS-RunShellScript"
+  instance_ids  = [aws_instance.test.id]
+  max_errors    = %[2]q
+
+  parameters = {
+    commands = %[1]q
+  }
+}
+`, commands, maxErrors))
+}
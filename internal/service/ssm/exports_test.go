@@ -7,12 +7,14 @@ package ssm
 var (
 	ResourceActivation              = resourceActivation
 	ResourceAssociation             = resourceAssociation
+	ResourceCommand                 = resourceCommand
 	ResourceDefaultPatchBaseline    = resourceDefaultPatchBaseline
 	ResourceDocument                = resourceDocument
 	ResourceMaintenanceWindow       = resourceMaintenanceWindow
 	ResourceMaintenanceWindowTarget = resourceMaintenanceWindowTarget
 	ResourceMaintenanceWindowTask   = resourceMaintenanceWindowTask
 	ResourceParameter               = resourceParameter
+	ResourceParameters              = newParametersResource
 	ResourcePatchBaseline           = resourcePatchBaseline
 	ResourcePatchGroup              = resourcePatchGroup
 	ResourceResourceDataSync        = resourceResourceDataSync
@@ -20,6 +22,7 @@ var (
 
 	FindActivationByID                                 = findActivationByID
 	FindAssociationByID                                = findAssociationByID
+	FindCommandByID                                    = findCommandByID
 	FindDefaultPatchBaselineByOperatingSystem          = findDefaultPatchBaselineByOperatingSystem
 	FindDefaultDefaultPatchBaselineIDByOperatingSystem = findDefaultDefaultPatchBaselineIDByOperatingSystem
 	FindDocumentByName                                 = findDocumentByName
@@ -27,6 +30,7 @@ var (
 	FindMaintenanceWindowTargetByTwoPartKey            = findMaintenanceWindowTargetByTwoPartKey
 	FindMaintenanceWindowTaskByTwoPartKey              = findMaintenanceWindowTaskByTwoPartKey
 	FindParameterByName                                = findParameterByName
+	FindParametersByPath                               = findParametersByPath
 	FindPatchBaselineByID                              = findPatchBaselineByID
 	FindPatchGroupByTwoPartKey                         = findPatchGroupByTwoPartKey
 	FindResourceDataSyncByName                         = findResourceDataSyncByName
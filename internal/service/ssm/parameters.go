@@ -0,0 +1,674 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+	"github.com/isometry/terraform-provider-faws/internal/errs/fwdiag"
+	"github.com/isometry/terraform-provider-faws/internal/framework"
+	fwflex "github.com/isometry/terraform-provider-faws/internal/framework/flex"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+const (
+	parametersDefaultConcurrency = 3
+	// Parameter Store's default throughput quota for PutParameter is 3 transactions per second.
+	parametersDefaultRequestsPerSecond = 3
+	// DeleteParameters accepts at most 10 names per request.
+	parametersDeleteBatchSize = 10
+	parametersPutTimeout      = 2 * time.Minute
+)
+
+var (
+	parametersPathRegexp = regexp.MustCompile(`^(/[0-9A-Za-z_.-]+)+$`)
+	parametersNameRegexp = regexp.MustCompile(`^[0-9A-Za-z_.-]+(/[0-9A-Za-z_.-]+)*$`)
+)
+
+// @FrameworkResource("aws_ssm_parameters", name="Parameters")
+func newParametersResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &parametersResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type parametersResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *parametersResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssm_parameters"
+}
+
+func (r *parametersResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"concurrency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(parametersDefaultConcurrency),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"delete_orphans": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKeyID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 400),
+				},
+			},
+			"orphans": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrParameters: schema.MapNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrType: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(string(awstypes.ParameterTypeString)),
+							Validators: []validator.String{
+								stringvalidator.OneOf(enum.Values[awstypes.ParameterType]()...),
+							},
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(parametersNameRegexp, "must be a relative parameter name"),
+					),
+				},
+			},
+			names.AttrPath: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(parametersPathRegexp, "must be a parameter hierarchy beginning, but not ending, with '/'"),
+				},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(parametersDefaultRequestsPerSecond),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *parametersResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data parametersResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	parameters, diags := data.parameters(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.CreateTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	parameterPath := data.Path.ValueString()
+
+	if err := putParameters(ctx, conn, parameterPath, data.KeyID.ValueString(), parameters, int(data.Concurrency.ValueInt64()), int(data.RequestsPerSecond.ValueInt64())); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSM Parameters (%s)", parameterPath), err.Error())
+
+		return
+	}
+
+	remote, err := findParametersByPath(ctx, conn, parameterPath)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameters (%s)", parameterPath), err.Error())
+
+		return
+	}
+
+	orphans := parametersOrphans(parameterPath, remote, parameters)
+
+	if data.DeleteOrphans.ValueBool() {
+		if err := deleteParametersByName(ctx, conn, parametersNames(parameterPath, orphans)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting SSM Parameters (%s) orphans", parameterPath), err.Error())
+
+			return
+		}
+
+		orphans = nil
+	}
+
+	// Set values for unknowns.
+	data.Orphans = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, orphans)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *parametersResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data parametersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	parameterPath := data.ID.ValueString()
+	remote, err := findParametersByPath(ctx, conn, parameterPath)
+
+	if err == nil && len(remote) == 0 {
+		err = tfresource.NewEmptyResultError(parameterPath)
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameters (%s)", parameterPath), err.Error())
+
+		return
+	}
+
+	var parameters map[string]parametersParameterModel
+
+	if data.Parameters.IsNull() {
+		// Import: the resource takes ownership of every parameter under the path.
+		parameters = make(map[string]parametersParameterModel, len(remote))
+		for name := range remote {
+			parameters[strings.TrimPrefix(name, parameterPath+"/")] = parametersParameterModel{}
+		}
+
+		data.Path = types.StringValue(parameterPath)
+		if data.Concurrency.IsNull() {
+			data.Concurrency = types.Int64Value(parametersDefaultConcurrency)
+		}
+		if data.DeleteOrphans.IsNull() {
+			data.DeleteOrphans = types.BoolValue(false)
+		}
+		if data.RequestsPerSecond.IsNull() {
+			data.RequestsPerSecond = types.Int64Value(parametersDefaultRequestsPerSecond)
+		}
+	} else {
+		var diags diag.Diagnostics
+		parameters, diags = data.parameters(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for name := range parameters {
+		v, ok := remote[parameterPath+"/"+name]
+		if !ok {
+			// Parameters deleted outside Terraform are recreated.
+			delete(parameters, name)
+			continue
+		}
+
+		parameters[name] = parametersParameterModel{
+			Type:  fwflex.StringValueToFramework(ctx, v.Type),
+			Value: fwflex.StringToFramework(ctx, v.Value),
+		}
+	}
+
+	response.Diagnostics.Append(data.setParameters(ctx, parameters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Orphans = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, parametersOrphans(parameterPath, remote, parameters))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *parametersResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old parametersResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	newParameters, diags := new.parameters(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	oldParameters, diags := old.parameters(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.UpdateTimeout(ctx, new.Timeouts))
+	defer cancel()
+
+	parameterPath := new.Path.ValueString()
+	keyChanged := !new.KeyID.Equal(old.KeyID)
+
+	var toDelete []string
+	toPut := make(map[string]parametersParameterModel)
+
+	for name, v := range oldParameters {
+		if n, ok := newParameters[name]; !ok || !n.Type.Equal(v.Type) {
+			// A parameter's type cannot be changed by overwriting it.
+			toDelete = append(toDelete, name)
+		}
+	}
+
+	for name, v := range newParameters {
+		o, ok := oldParameters[name]
+		switch {
+		case !ok, !o.Type.Equal(v.Type), !o.Value.Equal(v.Value):
+			toPut[name] = v
+		case keyChanged && awstypes.ParameterType(v.Type.ValueString()) == awstypes.ParameterTypeSecureString:
+			toPut[name] = v
+		}
+	}
+
+	if new.DeleteOrphans.ValueBool() {
+		remote, err := findParametersByPath(ctx, conn, parameterPath)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameters (%s)", parameterPath), err.Error())
+
+			return
+		}
+
+		// Newly declared parameters that already exist are overwritten, keeping their history.
+		toDelete = append(toDelete, parametersOrphans(parameterPath, remote, newParameters)...)
+	}
+
+	tflog.Debug(ctx, "updating SSM Parameters", map[string]any{
+		"path":   parameterPath,
+		"delete": len(toDelete),
+		"put":    len(toPut),
+		"total":  len(newParameters),
+	})
+
+	if err := deleteParametersByName(ctx, conn, parametersNames(parameterPath, toDelete)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SSM Parameters (%s)", parameterPath), err.Error())
+
+		return
+	}
+
+	if err := putParameters(ctx, conn, parameterPath, new.KeyID.ValueString(), toPut, int(new.Concurrency.ValueInt64()), int(new.RequestsPerSecond.ValueInt64())); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SSM Parameters (%s)", parameterPath), err.Error())
+
+		return
+	}
+
+	if new.Orphans.IsUnknown() {
+		remote, err := findParametersByPath(ctx, conn, parameterPath)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameters (%s)", parameterPath), err.Error())
+
+			return
+		}
+
+		new.Orphans = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, parametersOrphans(parameterPath, remote, newParameters))
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *parametersResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data parametersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMClient(ctx)
+
+	parameters, diags := data.parameters(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.DeleteTimeout(ctx, data.Timeouts))
+	defer cancel()
+
+	parameterPath := data.ID.ValueString()
+	toDelete := slices.Collect(maps.Keys(parameters))
+
+	if data.DeleteOrphans.ValueBool() {
+		// The resource is authoritative for every parameter under the path.
+		remote, err := findParametersByPath(ctx, conn, parameterPath)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting SSM Parameters (%s)", parameterPath), err.Error())
+
+			return
+		}
+
+		toDelete = append(toDelete, parametersOrphans(parameterPath, remote, parameters)...)
+	}
+
+	if err := deleteParametersByName(ctx, conn, parametersNames(parameterPath, toDelete)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSM Parameters (%s)", parameterPath), err.Error())
+
+		return
+	}
+}
+
+func (r *parametersResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+func (r *parametersResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan parametersResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DeleteOrphans.ValueBool() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("orphans"), fwflex.FlattenFrameworkStringValueSetLegacy[string](ctx, nil))...)
+
+		return
+	}
+
+	// On creation the orphans are not known until the path has been listed.
+	if request.State.Raw.IsNull() || plan.Parameters.IsUnknown() {
+		return
+	}
+
+	var state parametersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Orphans.IsNull() || state.Orphans.IsUnknown() {
+		return
+	}
+
+	// Declaring an orphan takes ownership of it, and parameters removed from the configuration are deleted.
+	planned := plan.Parameters.Elements()
+	var orphans []string
+	for _, name := range fwflex.ExpandFrameworkStringValueSet(ctx, state.Orphans) {
+		if _, ok := planned[name]; !ok {
+			orphans = append(orphans, name)
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("orphans"), fwflex.FlattenFrameworkStringValueSetLegacy(ctx, orphans))...)
+}
+
+// putParameters writes the specified parameters using at most `concurrency` concurrent requests,
+// starting at most `requestsPerSecond` requests per second.
+// Requests that still exceed Parameter Store's throughput quota are retried by the AWS SDK.
+func putParameters(ctx context.Context, conn *ssm.Client, parameterPath, keyID string, parameters map[string]parametersParameterModel, concurrency, requestsPerSecond int) error {
+	var (
+		errs []error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	semaphore := make(chan struct{}, max(concurrency, 1))
+	limiter := time.NewTicker(time.Second / time.Duration(max(requestsPerSecond, 1)))
+	defer limiter.Stop()
+
+	for i, name := range slices.Sorted(maps.Keys(parameters)) {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+			case <-limiter.C:
+			}
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		v := parameters[name]
+		input := &ssm.PutParameterInput{
+			Name:      aws.String(parameterPath + "/" + name),
+			Overwrite: aws.Bool(true),
+			Type:      awstypes.ParameterType(v.Type.ValueString()),
+			Value:     v.Value.ValueStringPointer(),
+		}
+
+		if keyID != "" && input.Type == awstypes.ParameterTypeSecureString {
+			input.KeyId = aws.String(keyID)
+		}
+
+		semaphore <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := putParameter(ctx, conn, input); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func putParameter(ctx context.Context, conn *ssm.Client, input *ssm.PutParameterInput) error {
+	_, err := tfresource.RetryWhenIsA[*awstypes.TooManyUpdates](ctx, parametersPutTimeout, func() (interface{}, error) {
+		return conn.PutParameter(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("putting SSM Parameter (%s): %w", aws.ToString(input.Name), err)
+	}
+
+	return nil
+}
+
+// deleteParametersByName deletes the specified parameters in batches.
+// Parameters that no longer exist are ignored.
+func deleteParametersByName(ctx context.Context, conn *ssm.Client, parameterNames []string) error {
+	slices.Sort(parameterNames)
+
+	for chunk := range slices.Chunk(parameterNames, parametersDeleteBatchSize) {
+		input := &ssm.DeleteParametersInput{
+			Names: chunk,
+		}
+
+		_, err := conn.DeleteParameters(ctx, input)
+
+		if errs.IsA[*awstypes.ParameterNotFound](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting SSM Parameters (%s): %w", strings.Join(chunk, ", "), err)
+		}
+	}
+
+	return nil
+}
+
+// findParametersByPath returns all the parameters under the specified path, keyed by name.
+func findParametersByPath(ctx context.Context, conn *ssm.Client, parameterPath string) (map[string]awstypes.Parameter, error) {
+	input := &ssm.GetParametersByPathInput{
+		Path:           aws.String(parameterPath),
+		Recursive:      aws.Bool(true),
+		WithDecryption: aws.Bool(true),
+	}
+	output := make(map[string]awstypes.Parameter)
+
+	pages := ssm.NewGetParametersByPathPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing SSM Parameters (%s): %w", parameterPath, err)
+		}
+
+		for _, v := range page.Parameters {
+			output[aws.ToString(v.Name)] = v
+		}
+	}
+
+	return output, nil
+}
+
+// parametersOrphans returns the relative names of the parameters under the path that are not declared.
+func parametersOrphans(parameterPath string, remote map[string]awstypes.Parameter, declared map[string]parametersParameterModel) []string {
+	var orphans []string
+
+	for name := range remote {
+		name = strings.TrimPrefix(name, parameterPath+"/")
+		if _, ok := declared[name]; !ok {
+			orphans = append(orphans, name)
+		}
+	}
+
+	slices.Sort(orphans)
+
+	return orphans
+}
+
+// parametersNames returns the full names of the specified relative parameter names.
+func parametersNames(parameterPath string, relativeNames []string) []string {
+	output := make([]string, 0, len(relativeNames))
+
+	for _, name := range relativeNames {
+		output = append(output, parameterPath+"/"+name)
+	}
+
+	return output
+}
+
+type parametersResourceModel struct {
+	Concurrency       types.Int64    `tfsdk:"concurrency"`
+	DeleteOrphans     types.Bool     `tfsdk:"delete_orphans"`
+	ID                types.String   `tfsdk:"id"`
+	KeyID             types.String   `tfsdk:"key_id"`
+	Orphans           types.Set      `tfsdk:"orphans"`
+	Parameters        types.Map      `tfsdk:"parameters"`
+	Path              types.String   `tfsdk:"path"`
+	RequestsPerSecond types.Int64    `tfsdk:"requests_per_second"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+type parametersParameterModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+var parametersParameterAttrTypes = map[string]attr.Type{
+	names.AttrType:  types.StringType,
+	names.AttrValue: types.StringType,
+}
+
+func (data *parametersResourceModel) setID() {
+	data.ID = data.Path
+}
+
+func (data *parametersResourceModel) parameters(ctx context.Context) (map[string]parametersParameterModel, diag.Diagnostics) {
+	var parameters map[string]parametersParameterModel
+
+	if data.Parameters.IsNull() || data.Parameters.IsUnknown() {
+		return parameters, nil
+	}
+
+	diags := data.Parameters.ElementsAs(ctx, &parameters, false)
+
+	return parameters, diags
+}
+
+func (data *parametersResourceModel) setParameters(ctx context.Context, parameters map[string]parametersParameterModel) diag.Diagnostics {
+	v, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: parametersParameterAttrTypes}, parameters)
+	data.Parameters = v
+
+	return diags
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/isometry/terraform-provider-faws/internal/acctest"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	tfssm "github.com/isometry/terraform-provider-faws/internal/service/ssm"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+func TestAccSSMParameters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "concurrency", "3"),
+					resource.TestCheckResourceAttr(resourceName, "delete_orphans", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, "/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "orphans.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.db/host.type", "String"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.db/host.value", "db.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.db/password.type", "SecureString"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.hosts.type", "StringList"),
+					resource.TestCheckResourceAttr(resourceName, "requests_per_second", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 3),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameters, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 3),
+				),
+			},
+			{
+				Config: testAccParametersConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.db/host.type", "SecureString"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.db/host.value", "db2.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.hosts.value", "a,b,c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameters_deleteOrphans(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParametersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 3),
+					testAccCheckParametersPutOrphan(ctx, resourceName, "orphan"),
+				),
+			},
+			{
+				Config: testAccParametersConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 4),
+					resource.TestCheckResourceAttr(resourceName, "orphans.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "orphans.*", "orphan"),
+				),
+			},
+			{
+				Config: testAccParametersConfig_deleteOrphans(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParametersCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "delete_orphans", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "orphans.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParametersCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("SSM Parameters (%s) count: got %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParametersPutOrphan(ctx context.Context, n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+			Name:  aws.String(rs.Primary.ID + "/" + name),
+			Type:  awstypes.ParameterTypeString,
+			Value: aws.String("orphan"),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckParametersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameters" {
+				continue
+			}
+
+			output, err := tfssm.FindParametersByPath(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			// Undeclared parameters are only deleted when delete_orphans is enabled.
+			var orphans int
+			if rs.Primary.Attributes["delete_orphans"] != acctest.CtTrue {
+				orphans, _ = strconv.Atoi(rs.Primary.Attributes["orphans.#"])
+			}
+
+			if len(output) > orphans {
+				return fmt.Errorf("SSM Parameters %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccParametersConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameters = {
+    "db/host" = {
+      value = "db.example.com"
+    }
+    "db/password" = {
+      type  = "SecureString"
+      value = "s3cr3t"
+    }
+    "hosts" = {
+      type  = "StringList"
+      value = "a,b"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path = "/%[1]s"
+
+  parameters = {
+    "db/host" = {
+      type  = "SecureString"
+      value = "db2.example.com"
+    }
+    "hosts" = {
+      type  = "StringList"
+      value = "a,b,c"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccParametersConfig_deleteOrphans(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameters" "test" {
+  path           = "/%[1]s"
+  delete_orphans = true
+
+  parameters = {
+    "db/host" = {
+      value = "db.example.com"
+    }
+    "db/password" = {
+      type  = "SecureString"
+      value = "s3cr3t"
+    }
+    "hosts" = {
+      type  = "StringList"
+      value = "a,b"
+    }
+  }
+}
+`, rName)
+}
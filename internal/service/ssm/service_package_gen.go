@@ -35,7 +35,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newParametersResource,
+			TypeName: "aws_ssm_parameters",
+			Name:     "Parameters",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -90,6 +96,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Association",
 			},
 		},
+		{
+			Factory:  resourceCommand,
+			TypeName: "aws_ssm_command",
+			Name:     "Command",
+		},
 		{
 			Factory:  resourceDefaultPatchBaseline,
 			TypeName: "aws_ssm_default_patch_baseline",
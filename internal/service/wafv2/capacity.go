@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/isometry/terraform-provider-faws/internal/conns"
+	"github.com/isometry/terraform-provider-faws/internal/enum"
+	"github.com/isometry/terraform-provider-faws/internal/errs"
+	"github.com/isometry/terraform-provider-faws/internal/tfresource"
+	"github.com/isometry/terraform-provider-faws/names"
+)
+
+type capacityCheckAction string
+
+const (
+	capacityCheckActionError capacityCheckAction = "ERROR"
+	capacityCheckActionWarn  capacityCheckAction = "WARN"
+)
+
+func (capacityCheckAction) Values() []capacityCheckAction {
+	return []capacityCheckAction{
+		capacityCheckActionError,
+		capacityCheckActionWarn,
+	}
+}
+
+func capacityCheckSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrAction: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          string(capacityCheckActionError),
+					ValidateDiagFunc: enum.Validate[capacityCheckAction](),
+				},
+				"threshold": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// findCapacityByRules returns the web ACL capacity units (WCUs) required by the specified rules.
+func findCapacityByRules(ctx context.Context, conn *wafv2.Client, scope string, rules []awstypes.Rule) (int64, error) {
+	if len(rules) == 0 {
+		return 0, nil
+	}
+
+	input := &wafv2.CheckCapacityInput{
+		Rules: rules,
+		Scope: awstypes.Scope(scope),
+	}
+
+	output, err := conn.CheckCapacity(ctx, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil {
+		return 0, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Capacity, nil
+}
+
+// rulesFromDiff expands the planned rules, from either rule or rule_json.
+// The returned bool is false if the rules are not yet known.
+func rulesFromDiff(d *schema.ResourceDiff, expand func([]interface{}) []awstypes.Rule) ([]awstypes.Rule, bool, error) {
+	plan := d.GetRawPlan()
+	if plan.IsNull() || !plan.GetAttr(names.AttrRule).IsWhollyKnown() || !plan.GetAttr("rule_json").IsWhollyKnown() {
+		return nil, false, nil
+	}
+
+	if v, ok := d.GetOk("rule_json"); ok {
+		rules, err := expandWebACLRulesJSON(v.(string))
+		if err != nil {
+			return nil, false, err
+		}
+
+		return rules, true, nil
+	}
+
+	return expand(d.Get(names.AttrRule).(*schema.Set).List()), true, nil
+}
+
+// planCapacity calls CheckCapacity with the planned rules when they are known.
+// The returned bool is false if the capacity cannot be determined during planning.
+func planCapacity(ctx context.Context, d *schema.ResourceDiff, meta interface{}, expand func([]interface{}) []awstypes.Rule) (int64, bool, error) {
+	rules, known, err := rulesFromDiff(d, expand)
+	if err != nil {
+		return 0, false, fmt.Errorf("expanding rules: %w", err)
+	}
+
+	if !known {
+		return 0, false, nil
+	}
+
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	capacity, err := findCapacityByRules(ctx, conn, d.Get(names.AttrScope).(string), rules)
+
+	// Only fail planning for errors caused by the rules themselves.
+	// Throttling, service errors and principals that aren't allowed to call CheckCapacity leave the capacity unknown.
+	if err != nil && !errs.IsA[*awstypes.WAFInvalidParameterException](err) && !errs.IsA[*awstypes.WAFInvalidResourceException](err) &&
+		!errs.IsA[*awstypes.WAFLimitsExceededException](err) && !errs.IsA[*awstypes.WAFNonexistentItemException](err) &&
+		!errs.IsA[*awstypes.WAFSubscriptionNotFoundException](err) && !errs.IsA[*awstypes.WAFExpiredManagedRuleGroupVersionException](err) {
+		tflog.Warn(ctx, "unable to check WAFv2 capacity during planning", map[string]any{
+			"error": err.Error(),
+		})
+		return 0, false, nil
+	}
+
+	if err != nil {
+		return 0, false, fmt.Errorf("checking capacity: %w", err)
+	}
+
+	return capacity, true, nil
+}
+
+// capacityThresholdExceeded returns a message and the configured action if capacity exceeds the capacity_check threshold.
+func capacityThresholdExceeded(tfList []interface{}, capacity int64) (string, capacityCheckAction, bool) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return "", "", false
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	threshold := int64(tfMap["threshold"].(int))
+
+	if capacity <= threshold {
+		return "", "", false
+	}
+
+	return fmt.Sprintf("rules require %d WCUs, exceeding the capacity_check threshold of %d", capacity, threshold), capacityCheckAction(tfMap[names.AttrAction].(string)), true
+}
+
+func ruleGroupCapacityCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges(names.AttrRule, "rule_json", "capacity") {
+		return nil
+	}
+
+	required, known, err := planCapacity(ctx, d, meta, expandRules)
+	if err != nil {
+		return fmt.Errorf("WAFv2 RuleGroup: %w", err)
+	}
+
+	if !known {
+		return nil
+	}
+
+	// A rule group's capacity is fixed on creation. When not configured, use the capacity of the initial rules.
+	if d.Id() == "" && d.GetRawConfig().GetAttr("capacity").IsNull() {
+		return d.SetNew("capacity", max(required, 1))
+	}
+
+	if !d.NewValueKnown("capacity") {
+		return nil
+	}
+
+	if capacity := int64(d.Get("capacity").(int)); required > capacity {
+		return fmt.Errorf("WAFv2 RuleGroup rules require %d WCUs, exceeding the rule group capacity of %d", required, capacity)
+	}
+
+	return nil
+}
+
+func webACLCapacityCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges(names.AttrRule, "rule_json", "capacity_check") {
+		return nil
+	}
+
+	// Only call CheckCapacity when a threshold is configured.
+	if v, ok := d.GetOk("capacity_check"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		if d.Id() != "" && d.HasChanges(names.AttrRule, "rule_json") {
+			return d.SetNewComputed("capacity")
+		}
+
+		return nil
+	}
+
+	capacity, known, err := planCapacity(ctx, d, meta, expandWebACLRules)
+	if err != nil {
+		return fmt.Errorf("WAFv2 WebACL: %w", err)
+	}
+
+	if !known {
+		if d.Id() != "" {
+			return d.SetNewComputed("capacity")
+		}
+
+		return nil
+	}
+
+	if err := d.SetNew("capacity", capacity); err != nil {
+		return err
+	}
+
+	if message, action, exceeded := capacityThresholdExceeded(d.Get("capacity_check").([]interface{}), capacity); exceeded {
+		if action == capacityCheckActionError {
+			return fmt.Errorf("WAFv2 WebACL %s", message)
+		}
+
+		if !conns.AddPlanWarning(ctx, "WAFv2 WebACL capacity", "WAFv2 WebACL "+message) {
+			tflog.Warn(ctx, "WAFv2 WebACL "+message)
+		}
+	}
+
+	return nil
+}
+
+// ruleGroupCapacityForCreate returns the configured capacity of a new rule group, calculating it from its rules if not known during planning.
+func ruleGroupCapacityForCreate(ctx context.Context, conn *wafv2.Client, d *schema.ResourceData, rules []awstypes.Rule) (*int64, error) {
+	if v, ok := d.GetOk("capacity"); ok {
+		return aws.Int64(int64(v.(int))), nil
+	}
+
+	capacity, err := findCapacityByRules(ctx, conn, d.Get(names.AttrScope).(string), rules)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return aws.Int64(max(capacity, 1)), nil
+}
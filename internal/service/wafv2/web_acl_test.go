@@ -16,7 +16,10 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/isometry/terraform-provider-faws/internal/acctest"
 	"github.com/isometry/terraform-provider-faws/internal/conns"
 	tfwafv2 "github.com/isometry/terraform-provider-faws/internal/service/wafv2"
@@ -3142,6 +3145,41 @@ func TestAccWAFV2WebACL_ruleJSONToRule(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACL_capacityCheck(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebACLConfig_capacityCheck(webACLName, "ERROR"),
+				ExpectError: regexache.MustCompile(`rules require \d+ WCUs, exceeding the capacity_check threshold of 1`),
+			},
+			{
+				Config: testAccWebACLConfig_capacityCheck(webACLName, "WARN"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("capacity"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "capacity"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_check.0.action", "WARN"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_check.0.threshold", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckWebACLDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Client(ctx)
@@ -6225,6 +6263,54 @@ resource "aws_wafv2_web_acl" "test" {
 `, rName)
 }
 
+func testAccWebACLConfig_capacityCheck(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  capacity_check {
+    action    = %[2]q
+    threshold = 1
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+
+  rule_json = jsonencode([{
+    Name     = "rule-1"
+    Priority = 1
+    Action = {
+      Count = {}
+    }
+    Statement = {
+      SqliMatchStatement = {
+        FieldToMatch = {
+          QueryString = {}
+        }
+        TextTransformations = [{
+          Priority = 0
+          Type     = "URL_DECODE"
+        }]
+      }
+    }
+    VisibilityConfig = {
+      CloudwatchMetricsEnabled = false
+      MetricName               = "friendly-rule-metric-name"
+      SampledRequestsEnabled   = false
+    }
+  }])
+}
+`, rName, action)
+}
+
 func testAccWebACLConfig_jsonRuleUpdate(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_web_acl" "test" {
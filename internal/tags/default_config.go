@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"path"
+)
+
+// ScopedDefaultConfig contains tags to default across resources whose type matches
+// any of IncludeResourceTypes (or all resources if empty) and none of ExcludeResourceTypes.
+// Resource type patterns use `path.Match` syntax, e.g. `aws_autoscaling_*`.
+type ScopedDefaultConfig struct {
+	Tags                 KeyValueTags
+	IncludeResourceTypes []string
+	ExcludeResourceTypes []string
+}
+
+// appliesTo returns whether the scoped tags apply to the specified resource type.
+func (sc ScopedDefaultConfig) appliesTo(resourceType string) bool {
+	for _, pattern := range sc.ExcludeResourceTypes {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return false
+		}
+	}
+
+	if len(sc.IncludeResourceTypes) == 0 {
+		return true
+	}
+
+	for _, pattern := range sc.IncludeResourceTypes {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForResourceType returns the DefaultConfig resolved for the specified resource type.
+// The tags of each scope that applies to the resource type are merged, in order, over the unscoped tags.
+func (dc *DefaultConfig) ForResourceType(resourceType string) *DefaultConfig {
+	if dc == nil || len(dc.Scoped) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+	for _, v := range dc.Scoped {
+		if v.appliesTo(resourceType) {
+			tags = tags.Merge(v.Tags)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
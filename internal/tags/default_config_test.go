@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "platform",
+		}),
+		Scoped: []ScopedDefaultConfig{
+			{
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "1234",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group", "aws_elastic_beanstalk_*"},
+			},
+			{
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+					"Owner":  "data",
+				}),
+				IncludeResourceTypes: []string{"aws_db_*", "aws_rds_*"},
+				ExcludeResourceTypes: []string{"aws_db_subnet_group"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceType  string
+		want          map[string]string
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			resourceType:  "aws_vpc",
+		},
+		{
+			name: "no scopes",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "platform",
+				}),
+			},
+			resourceType: "aws_vpc",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:          "unscoped and exclude-only scope",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_vpc",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "platform",
+			},
+		},
+		{
+			name:          "excluded",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_autoscaling_group",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:          "excluded by pattern",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_elastic_beanstalk_environment",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:          "included overrides earlier tags",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_db_instance",
+			want: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "1234",
+				"Owner":      "data",
+			},
+		},
+		{
+			name:          "exclude takes precedence over include",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_db_subnet_group",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "platform",
+			},
+		},
+		{
+			name: "no matching tags",
+			defaultConfig: &DefaultConfig{
+				Scoped: []ScopedDefaultConfig{
+					{
+						Tags: New(ctx, map[string]string{
+							"Backup": "daily",
+						}),
+						IncludeResourceTypes: []string{"aws_db_*"},
+					},
+				},
+			},
+			resourceType: "aws_vpc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.resourceType)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got.Tags.Map())
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected %v, got nil", testCase.want)
+			}
+			if len(got.Scoped) != 0 {
+				t.Errorf("expected no scopes, got %d", len(got.Scoped))
+			}
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
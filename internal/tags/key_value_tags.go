@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -52,12 +53,17 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// Scoped contains tags to default across resources of matching types.
+	// Use ForResourceType to resolve the tags for a resource type.
+	Scoped []ScopedDefaultConfig
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	Keys         KeyValueTags
+	KeyPrefixes  KeyValueTags
+	KeyRegexes   []*regexp.Regexp
+	ValueRegexes []*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -126,6 +132,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreKeyRegexes(config.KeyRegexes)
+	result = result.IgnoreValueRegexes(config.ValueRegexes)
 
 	return result
 }
@@ -177,6 +185,40 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreKeyRegexes returns tags whose keys match none of the regular expressions.
+func (tags KeyValueTags) IgnoreKeyRegexes(ignoreKeyRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreKeyRegexes, func(re *regexp.Regexp) bool {
+			return re.MatchString(k)
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValueRegexes returns tags whose values match none of the regular expressions.
+func (tags KeyValueTags) IgnoreValueRegexes(ignoreValueRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreValueRegexes, func(re *regexp.Regexp) bool {
+			return re.MatchString(v.ValueString())
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -5,8 +5,10 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/isometry/terraform-provider-faws/names"
@@ -564,6 +566,69 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes",
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/example": "owned",
+				"kubernetes.io/role/elb":        "1",
+				"key3":                          "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^kubernetes\.io/cluster/`),
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/role/elb": "1",
+				"key3":                   "value3",
+			},
+		},
+		{
+			name: "value regexes",
+			tags: New(ctx, map[string]string{
+				"key1": "arn:aws:backup:us-west-2:123456789012:recovery-point:1", //lintignore:AWSAT003,AWSAT005
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValueRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^arn:[^:]+:backup:`),
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys key prefixes key regexes and value regexes",
+			tags: New(ctx, map[string]string{
+				"key1":   "value1",
+				"key2":   "value2",
+				"key3":   "value3",
+				"other4": "value4",
+				"other5": "ignored",
+				"other6": "value6",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{
+					"key1",
+				}),
+				KeyPrefixes: New(ctx, []string{
+					"key2",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^key\d$`),
+					regexache.MustCompile(`4$`),
+				},
+				ValueRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^ignored$`),
+				},
+			},
+			want: map[string]string{
+				"other6": "value6",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -103,6 +103,109 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// PlanTimeValueFunc returns the value of a Computed attribute determined by a resource's planned arguments.
+// It returns false if the value can't be determined until apply, e.g. because an argument's value is unknown.
+type PlanTimeValueFunc func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) (string, bool)
+
+// SetPlanTimeValue returns a CustomizeDiffFunc that sets the planned value of the specified Computed attribute
+// of a resource that is to be created (or replaced) to the value returned by f, so that the value is known
+// during plan rather than after apply.
+func SetPlanTimeValue(key string, f PlanTimeValueFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() != "" {
+			return nil
+		}
+
+		v, ok := f(ctx, diff, meta)
+		if !ok {
+			return nil
+		}
+
+		if err := diff.SetNew(key, v); err != nil {
+			return fmt.Errorf("setting %s diff: %w", key, err)
+		}
+
+		return nil
+	}
+}
+
+// PlannedString returns the planned value of the specified string argument.
+// It returns false if the value is unknown or empty, e.g. a name to be generated during apply.
+func PlannedString(diff *schema.ResourceDiff, key string) (string, bool) {
+	if !diff.NewValueKnown(key) {
+		return "", false
+	}
+
+	v, _ := diff.Get(key).(string)
+
+	return v, v != ""
+}
+
+// GlobalARNFromPlan returns a PlanTimeValueFunc that computes a global (no Region) ARN for the specified
+// service namespace, with the resource formatted from the planned values of the specified string arguments.
+// e.g. GlobalARNFromPlan("iam", "role%s%s", names.AttrPath, names.AttrName).
+func GlobalARNFromPlan(service, format string, keys ...string) PlanTimeValueFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) (string, bool) {
+		c := meta.(*conns.AWSClient)
+		if c.AccountID(ctx) == "" {
+			return "", false
+		}
+
+		resource, ok := plannedResource(diff, format, keys)
+		if !ok {
+			return "", false
+		}
+
+		return c.GlobalARN(ctx, service, resource), true
+	}
+}
+
+// GlobalARNNoAccountFromPlan returns a PlanTimeValueFunc that computes a global (no Region) ARN without AWS account ID
+// for the specified service namespace, with the resource formatted from the planned values of the specified string arguments.
+// e.g. GlobalARNNoAccountFromPlan("s3", "%s", names.AttrBucket).
+func GlobalARNNoAccountFromPlan(service, format string, keys ...string) PlanTimeValueFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) (string, bool) {
+		resource, ok := plannedResource(diff, format, keys)
+		if !ok {
+			return "", false
+		}
+
+		return meta.(*conns.AWSClient).GlobalARNNoAccount(ctx, service, resource), true
+	}
+}
+
+// RegionalARNFromPlan returns a PlanTimeValueFunc that computes a regional ARN for the specified
+// service namespace, with the resource formatted from the planned values of the specified string arguments.
+// e.g. RegionalARNFromPlan("logs", "log-group:%s", names.AttrName).
+func RegionalARNFromPlan(service, format string, keys ...string) PlanTimeValueFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) (string, bool) {
+		c := meta.(*conns.AWSClient)
+		if c.AccountID(ctx) == "" {
+			return "", false
+		}
+
+		resource, ok := plannedResource(diff, format, keys)
+		if !ok {
+			return "", false
+		}
+
+		return c.RegionalARN(ctx, service, resource), true
+	}
+}
+
+func plannedResource(diff *schema.ResourceDiff, format string, keys []string) (string, bool) {
+	args := make([]any, len(keys))
+	for i, key := range keys {
+		v, ok := PlannedString(diff, key)
+		if !ok {
+			return "", false
+		}
+		args[i] = v
+	}
+
+	return fmt.Sprintf(format, args...), true
+}
+
 // SuppressEquivalentRoundedTime returns a difference suppression function that compares
 // two time value with the specified layout rounded to the specified duration.
 func SuppressEquivalentRoundedTime(layout string, d time.Duration) schema.SchemaDiffSuppressFunc {
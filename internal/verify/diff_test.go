@@ -4,8 +4,13 @@
 package verify
 
 import (
+	"context"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/isometry/terraform-provider-faws/names"
 )
 
 func TestSuppressEquivalentRoundedTime(t *testing.T) {
@@ -60,3 +65,100 @@ func TestSuppressEquivalentRoundedTime(t *testing.T) {
 		}
 	}
 }
+
+func TestSetPlanTimeValue(t *testing.T) {
+	t.Parallel()
+
+	// The value of an unknown configuration value in the legacy SDK type system.
+	const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	ctx := context.Background()
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		CustomizeDiff: SetPlanTimeValue(names.AttrARN, func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) (string, bool) {
+			name, ok := PlannedString(diff, names.AttrName)
+			if !ok {
+				return "", false
+			}
+
+			return "arn:aws:test:::" + name, true //lintignore:AWSAT005
+		}),
+	}
+
+	testCases := map[string]struct {
+		state       *terraform.InstanceState
+		config      map[string]any
+		expected    string
+		expectKnown bool
+	}{
+		"create": {
+			config: map[string]any{
+				names.AttrName: "test",
+			},
+			expected:    "arn:aws:test:::test", //lintignore:AWSAT005
+			expectKnown: true,
+		},
+		"create name unknown": {
+			config: map[string]any{
+				names.AttrName: unknownVariableValue,
+			},
+		},
+		"create name generated": {
+			config: map[string]any{},
+		},
+		"update": {
+			state: &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					names.AttrARN:  "arn:aws:test:::test", //lintignore:AWSAT005
+					names.AttrName: "test",
+				},
+			},
+			config: map[string]any{
+				names.AttrName: "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diff, err := r.SimpleDiff(ctx, testCase.state, terraform.NewResourceConfigRaw(testCase.config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var attr *terraform.ResourceAttrDiff
+			if diff != nil {
+				attr = diff.Attributes[names.AttrARN]
+			}
+
+			switch {
+			case testCase.expectKnown:
+				if attr == nil || attr.NewComputed || attr.New != testCase.expected {
+					t.Errorf("expected planned value %q, got %#v", testCase.expected, attr)
+				}
+			case testCase.state == nil:
+				if attr == nil || !attr.NewComputed {
+					t.Errorf("expected unknown planned value, got %#v", attr)
+				}
+			default:
+				if attr != nil {
+					t.Errorf("expected no diff, got %#v", attr)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	_ "embed"
+	"encoding/json"
+)
+
+// ServiceAvailability maps service packages to the IDs of the Regions, by partition ID, in which the service is available.
+// Partitions in which a service's availability is unknown are omitted.
+type ServiceAvailability map[string]map[string][]string
+
+// service_availability.json is generated from the AWS SDK's endpoint metadata by `make gen`.
+//
+//go:embed service_availability.json
+var serviceAvailability []byte
+
+func ReadServiceAvailability() (ServiceAvailability, error) {
+	var result ServiceAvailability
+
+	if err := json.Unmarshal(serviceAvailability, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
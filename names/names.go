@@ -18,6 +18,8 @@ package names
 import (
 	"fmt"
 	"log"
+	"slices"
+	"sync"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/isometry/terraform-provider-faws/names/data"
@@ -160,6 +162,25 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+var serviceAvailability = sync.OnceValues(data.ReadServiceAvailability)
+
+// ServiceAvailableInRegion returns whether the service package is available in the given Region of the given partition,
+// according to the AWS SDK's endpoint metadata at the time the provider was built.
+// known is false if the service's availability in the partition is unknown, e.g. for global services.
+func ServiceAvailableInRegion(service, partition, region string) (available, known bool) {
+	availability, err := serviceAvailability()
+	if err != nil {
+		return false, false
+	}
+
+	regions, ok := availability[service][partition]
+	if !ok {
+		return false, false
+	}
+
+	return slices.Contains(regions, region), true
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
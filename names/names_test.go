@@ -59,6 +59,59 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestServiceAvailableInRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		service       string
+		partition     string
+		region        string
+		wantAvailable bool
+		wantKnown     bool
+	}{
+		{
+			name:          "available",
+			service:       "ec2",
+			partition:     endpoints.AwsPartitionID,
+			region:        endpoints.UsWest2RegionID,
+			wantAvailable: true,
+			wantKnown:     true,
+		},
+		{
+			name:      "unavailable",
+			service:   "m2",
+			partition: endpoints.AwsPartitionID,
+			region:    endpoints.MeCentral1RegionID,
+			wantKnown: true,
+		},
+		{
+			name:      "global service",
+			service:   "iam",
+			partition: endpoints.AwsPartitionID,
+			region:    endpoints.UsWest2RegionID,
+		},
+		{
+			name:      "unknown partition",
+			service:   "ec2",
+			partition: "aws-example",
+			region:    "example-east-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			available, known := ServiceAvailableInRegion(testCase.service, testCase.partition, testCase.region)
+
+			if available != testCase.wantAvailable || known != testCase.wantKnown {
+				t.Errorf("got: %t, %t, expected: %t, %t", available, known, testCase.wantAvailable, testCase.wantKnown)
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	t.Parallel()
 